@@ -45,9 +45,11 @@ function(ADD_GO_INSTALLABLE_PROGRAM)
 			OUTPUT ${GO_PROGRAM_MAIN_SOURCE}
 			INPUT ${GO_PROGRAM_GOPATH}/${GO_PROGRAM_MAIN_SOURCE}.configured)
 	else()
+		# Copy the whole directory, as the program may be split across files
+		# with build constraints
 		add_custom_command(TARGET ${GO_PROGRAM_TARGET}_copy
 			COMMAND ${CMAKE_COMMAND} -E
-			copy ${MAIN_SRC_ABS} ${GO_PROGRAM_GOPATH}/${GO_PROGRAM_MAIN_SOURCE}
+			copy_directory ${MAIN_SRC_ABS_DIR} ${GO_PROGRAM_GOPATH_MAIN_SOURCE_DIR}
 			# The copy command depends on the original source file
 			DEPENDS ${MAIN_SRC_ABS})
 	endif()
@@ -74,11 +76,11 @@ function(ADD_GO_INSTALLABLE_PROGRAM)
 		WORKING_DIRECTORY ${GO_PROGRAM_GOPATH_MAIN_SOURCE_DIR}
 		DEPENDS ${GO_PROGRAM_TARGET}_copy)
 
-	# Now actually setup the build to go build the file inside of the gopath
+	# Now actually setup the build to go build the package of the file inside of the gopath
 	add_custom_command(TARGET ${GO_PROGRAM_TARGET}
 		COMMAND ${CMAKE_COMMAND} -E env ${GO_PROGRAM_GO_ENVIRONMENT} GOPATH=${GOPATH} go build -v 
 		-o ${CMAKE_CURRENT_BINARY_DIR}/${GO_PROGRAM_TARGET}
-		${CMAKE_GO_FLAGS} ${GO_PROGRAM_GOPATH}/${GO_PROGRAM_MAIN_SOURCE}
+		${CMAKE_GO_FLAGS} ${GO_PROGRAM_IMPORT_PATH}/${MAIN_SRC_DIR}
 		WORKING_DIRECTORY ${GO_PROGRAM_GOPATH}
 		DEPENDS ${GO_PROGRAM_TARGET}_copy)
 
@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	// schemaVersion is bumped whenever the layout of ResultSet changes in
+	// an incompatible way
+	schemaVersion = 1
+)
+
+// ResultSet is a single run of `go test -bench` on one device with one
+// permutation backend, as stored in the JSON files
+type ResultSet struct {
+	Schema     int          `json:"schema"`
+	Device     string       `json:"device"`
+	GOOS       string       `json:"goos"`
+	GOARCH     string       `json:"goarch"`
+	Backend    string       `json:"backend"`
+	Package    string       `json:"package,omitempty"`
+	Benchmarks []*Benchmark `json:"benchmarks"`
+}
+
+// Benchmark holds all of the samples for a single benchmark name - there
+// is more than one sample when go test was run with -count
+type Benchmark struct {
+	Name    string   `json:"name"`
+	Procs   int      `json:"procs"`
+	Samples []Sample `json:"samples"`
+}
+
+// Sample is a single line of benchmark output
+type Sample struct {
+	Iterations  int64   `json:"iterations"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	MBPerSec    float64 `json:"mb_per_sec,omitempty"`
+	BytesPerOp  float64 `json:"bytes_per_op,omitempty"`
+	AllocsPerOp float64 `json:"allocs_per_op,omitempty"`
+}
+
+// NsPerOp returns all the ns/op measurements for this benchmark
+func (b *Benchmark) NsPerOp() []float64 {
+	vals := make([]float64, len(b.Samples))
+	for i, s := range b.Samples {
+		vals[i] = s.NsPerOp
+	}
+	return vals
+}
+
+// lookup returns the benchmark with the given name, or nil if it doesn't exist
+func (r *ResultSet) lookup(name string) *Benchmark {
+	for _, b := range r.Benchmarks {
+		if b.Name == name {
+			return b
+		}
+	}
+	return nil
+}
+
+// ParseBenchText parses the raw text output of `go test -bench` into a
+// ResultSet. The goos, goarch and pkg header lines are used if present.
+func ParseBenchText(r io.Reader) (*ResultSet, error) {
+	res := &ResultSet{Schema: schemaVersion}
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "goos:"):
+			res.GOOS = strings.TrimSpace(strings.TrimPrefix(line, "goos:"))
+		case strings.HasPrefix(line, "goarch:"):
+			res.GOARCH = strings.TrimSpace(strings.TrimPrefix(line, "goarch:"))
+		case strings.HasPrefix(line, "pkg:"):
+			res.Package = strings.TrimSpace(strings.TrimPrefix(line, "pkg:"))
+		case strings.HasPrefix(line, "Benchmark"):
+			name, procs, sample, ok, err := parseBenchLine(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", lineNum, err)
+			}
+			if !ok {
+				// a line such as the bare benchmark name that go test -v
+				// prints before b.Log output, which isn't a result
+				continue
+			}
+			b := res.lookup(name)
+			if b == nil {
+				b = &Benchmark{Name: name, Procs: procs}
+				res.Benchmarks = append(res.Benchmarks, b)
+			}
+			b.Samples = append(b.Samples, sample)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// parseBenchLine parses a single result line such as
+//
+//	BenchmarkSha3_512_MTU-4   	   30000	     52601 ns/op	  25.66 MB/s
+//
+// ok is false if the line isn't a result, i.e. it doesn't have an iteration
+// count followed by value/unit pairs
+func parseBenchLine(line string) (name string, procs int, sample Sample, ok bool, err error) {
+	fields := strings.Fields(line)
+	// need at least the name, the iteration count and one value/unit pair
+	if len(fields) < 4 || len(fields)%2 != 0 {
+		return "", 0, sample, false, nil
+	}
+	iters, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return "", 0, sample, false, nil
+	}
+	sample.Iterations = iters
+
+	// split off the GOMAXPROCS suffix from the name if there is one
+	name, procs = fields[0], 1
+	if i := strings.LastIndex(name, "-"); i > 0 {
+		if n, err := strconv.Atoi(name[i+1:]); err == nil {
+			name, procs = name[:i], n
+		}
+	}
+
+	for i := 2; i < len(fields); i += 2 {
+		val, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return "", 0, sample, false, fmt.Errorf("invalid value %q", fields[i])
+		}
+		switch fields[i+1] {
+		case "ns/op":
+			sample.NsPerOp = val
+		case "MB/s":
+			sample.MBPerSec = val
+		case "B/op":
+			sample.BytesPerOp = val
+		case "allocs/op":
+			sample.AllocsPerOp = val
+		}
+	}
+	return name, procs, sample, true, nil
+}
+
+// loadResultSet reads either a JSON result set or a raw benchmark text file.
+// For text files the device and backend are taken from the path if they
+// aren't specified, following the layout benchmarks/<device>/<backend>.txt
+func loadResultSet(filename, device, backend string) (*ResultSet, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var res *ResultSet
+	if strings.HasSuffix(filename, ".json") {
+		res = &ResultSet{}
+		if err := json.NewDecoder(f).Decode(res); err != nil {
+			return nil, fmt.Errorf("%s: %v", filename, err)
+		}
+		if res.Schema != schemaVersion {
+			return nil, fmt.Errorf("%s: unsupported schema version %d", filename, res.Schema)
+		}
+	} else {
+		res, err = ParseBenchText(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", filename, err)
+		}
+		if res.Device == "" {
+			res.Device = filepath.Base(filepath.Dir(filename))
+		}
+		if res.Backend == "" {
+			res.Backend = strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+		}
+	}
+
+	// Flags always override whatever was in the file
+	if device != "" {
+		res.Device = device
+	}
+	if backend != "" {
+		res.Backend = backend
+	}
+	return res, nil
+}
+
+// mean returns the arithmetic mean of the values
+func mean(vals []float64) float64 {
+	var sum float64
+	for _, v := range vals {
+		sum += v
+	}
+	return sum / float64(len(vals))
+}
+
+// variance returns the unbiased sample variance of the values
+func variance(vals []float64) float64 {
+	m := mean(vals)
+	var sum float64
+	for _, v := range vals {
+		sum += (v - m) * (v - m)
+	}
+	return sum / float64(len(vals)-1)
+}
+
+// welchTTest returns the two-tailed p-value of Welch's unequal variances
+// t-test for the two sets of samples. It returns NaN if there aren't at
+// least two samples in each set, in which case nothing can be said about
+// significance.
+func welchTTest(a, b []float64) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return math.NaN()
+	}
+	va, vb := variance(a)/float64(len(a)), variance(b)/float64(len(b))
+	if va+vb == 0 {
+		// No variance at all, so any difference is significant
+		if mean(a) == mean(b) {
+			return 1
+		}
+		return 0
+	}
+	t := (mean(a) - mean(b)) / math.Sqrt(va+vb)
+	dof := (va + vb) * (va + vb) / (va*va/float64(len(a)-1) + vb*vb/float64(len(b)-1))
+	// The two tailed p-value of the t distribution is I_{dof/(dof+t^2)}(dof/2, 1/2)
+	return regIncBeta(dof/2, 0.5, dof/(dof+t*t))
+}
+
+// regIncBeta computes the regularized incomplete beta function I_x(a, b)
+// using the continued fraction from Numerical Recipes
+func regIncBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	lga, _ := math.Lgamma(a)
+	lgb, _ := math.Lgamma(b)
+	lgab, _ := math.Lgamma(a + b)
+	front := math.Exp(lgab - lga - lgb + a*math.Log(x) + b*math.Log(1-x))
+	// The continued fraction converges quickly only for x < (a+1)/(a+b+2),
+	// otherwise use the symmetry relation
+	if x > (a+1)/(a+b+2) {
+		return 1 - regIncBeta(b, a, 1-x)
+	}
+	return front * betaContinuedFraction(a, b, x) / a
+}
+
+// betaContinuedFraction evaluates the continued fraction for the incomplete
+// beta function with the modified Lentz method
+func betaContinuedFraction(a, b, x float64) float64 {
+	const (
+		maxIter = 200
+		epsilon = 1e-14
+		tiny    = 1e-300
+	)
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= maxIter; m++ {
+		fm := float64(m)
+		// even step
+		num := fm * (b - fm) * x / ((a + 2*fm - 1) * (a + 2*fm))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+		// odd step
+		num = -(a + fm) * (a + b + fm) * x / ((a + 2*fm) * (a + 2*fm + 1))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
+
+// comparison is a single row of the comparison table
+type comparison struct {
+	name     string
+	old, new []float64
+	speedup  float64
+	pValue   float64
+}
+
+// compareResultSets matches up benchmarks by name between the two sets,
+// in the order in which they appear in the old set
+func compareResultSets(oldRes, newRes *ResultSet) []comparison {
+	var rows []comparison
+	for _, ob := range oldRes.Benchmarks {
+		nb := newRes.lookup(ob.Name)
+		if nb == nil {
+			continue
+		}
+		oldNs, newNs := ob.NsPerOp(), nb.NsPerOp()
+		rows = append(rows, comparison{
+			name:    ob.Name,
+			old:     oldNs,
+			new:     newNs,
+			speedup: mean(oldNs) / mean(newNs),
+			pValue:  welchTTest(oldNs, newNs),
+		})
+	}
+	return rows
+}
+
+// describe returns a short label for a result set to use in table headers
+func describe(r *ResultSet) string {
+	parts := []string{}
+	for _, p := range []string{r.Device, r.GOARCH, r.Backend} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// formatNs formats the mean of the samples, with the relative spread when
+// there is more than one sample
+func formatNs(vals []float64) string {
+	m := mean(vals)
+	if len(vals) < 2 || m == 0 {
+		return fmt.Sprintf("%.0f", m)
+	}
+	return fmt.Sprintf("%.0f ±%.1f%%", m, 100*math.Sqrt(variance(vals))/m)
+}
+
+// printComparison prints the speedup table and returns the number of
+// statistically significant regressions, i.e. benchmarks that got slower by
+// more than threshold with a p-value below alpha
+func printComparison(w io.Writer, oldRes, newRes *ResultSet, alpha, threshold float64) int {
+	rows := compareResultSets(oldRes, newRes)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "benchmark\t%s ns/op\t%s ns/op\tspeedup\tp\t\n", describe(oldRes), describe(newRes))
+	var regressions []string
+	for _, row := range rows {
+		pStr, mark := "~", ""
+		if !math.IsNaN(row.pValue) {
+			pStr = fmt.Sprintf("%.3f", row.pValue)
+			if row.pValue < alpha {
+				mark = " *"
+				if row.speedup < 1-threshold {
+					regressions = append(regressions, row.name)
+				}
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2fx%s\t%s\t\n",
+			row.name, formatNs(row.old), formatNs(row.new), row.speedup, mark, pStr)
+	}
+	tw.Flush()
+
+	// Report the geometric mean of the speedups across all benchmarks
+	if len(rows) > 0 {
+		var logSum float64
+		for _, row := range rows {
+			logSum += math.Log(row.speedup)
+		}
+		fmt.Fprintf(w, "\ngeomean speedup: %.2fx over %d benchmarks\n", math.Exp(logSum/float64(len(rows))), len(rows))
+	}
+
+	if len(regressions) > 0 {
+		sort.Strings(regressions)
+		fmt.Fprintf(w, "\nsignificant regressions (p < %g, slowdown > %g%%):\n", alpha, threshold*100)
+		for _, name := range regressions {
+			fmt.Fprintf(w, "  %s\n", name)
+		}
+	}
+	return len(regressions)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `usage:
+  benchcmp convert [-device name] [-backend name] [-o out.json] bench.txt
+  benchcmp compare [-alpha p] [-threshold frac] old.{txt,json} new.{txt,json}
+
+Text files laid out as benchmarks/<device>/<backend>.txt are tagged with the
+device and backend from their path unless overridden.
+`)
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "convert":
+		fs := flag.NewFlagSet("convert", flag.ExitOnError)
+		device := fs.String("device", "", "device name to tag the results with")
+		backend := fs.String("backend", "", "backend name to tag the results with (i.e. generic, neon)")
+		outFile := fs.String("o", "", "output file, defaults to stdout")
+		fs.Parse(os.Args[2:])
+		if fs.NArg() != 1 {
+			usage()
+		}
+
+		res, err := loadResultSet(fs.Arg(0), *device, *backend)
+		if err != nil {
+			log.Fatalf("error : %v", err)
+		}
+
+		out := os.Stdout
+		if *outFile != "" {
+			out, err = os.Create(*outFile)
+			if err != nil {
+				log.Fatalf("error : %v", err)
+			}
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "\t")
+		if err := enc.Encode(res); err != nil {
+			log.Fatalf("error : %v", err)
+		}
+		// the write may only fail when the file is closed
+		if out != os.Stdout {
+			if err := out.Close(); err != nil {
+				log.Fatalf("error : %v", err)
+			}
+		}
+	case "compare":
+		fs := flag.NewFlagSet("compare", flag.ExitOnError)
+		alpha := fs.Float64("alpha", 0.05, "significance level for Welch's t-test")
+		threshold := fs.Float64("threshold", 0.02, "minimum relative slowdown to report as a regression")
+		fs.Parse(os.Args[2:])
+		if fs.NArg() != 2 {
+			usage()
+		}
+
+		oldRes, err := loadResultSet(fs.Arg(0), "", "")
+		if err != nil {
+			log.Fatalf("error : %v", err)
+		}
+		newRes, err := loadResultSet(fs.Arg(1), "", "")
+		if err != nil {
+			log.Fatalf("error : %v", err)
+		}
+
+		// Exit with non-zero status if there were any regressions so this
+		// can be used in scripts
+		if printComparison(os.Stdout, oldRes, newRes, *alpha, *threshold) > 0 {
+			os.Exit(1)
+		}
+	default:
+		usage()
+	}
+}
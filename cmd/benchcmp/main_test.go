@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+const benchText = `goos: linux
+goarch: arm
+pkg: github.com/anonymouse64/sha3_arm/sha3_fast
+BenchmarkPermutationFunction-4   	  200000	      6750 ns/op	  29.63 MB/s
+BenchmarkPermutationFunction-4   	  200000	      6770 ns/op	  29.54 MB/s
+BenchmarkSha3_512_MTU
+    sha3_test.go:321: some output from b.Log
+BenchmarkSha3_512_MTU-4          	   30000	     52601 ns/op	  25.66 MB/s	     512 B/op	       2 allocs/op
+--- BENCH: BenchmarkSha3_512_MTU-4
+BenchmarkShake256
+PASS
+ok  	github.com/anonymouse64/sha3_arm/sha3_fast	5.123s
+`
+
+func TestParseBenchText(t *testing.T) {
+	res, err := ParseBenchText(strings.NewReader(benchText))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GOOS != "linux" || res.GOARCH != "arm" || res.Package != "github.com/anonymouse64/sha3_arm/sha3_fast" {
+		t.Errorf("got header %q %q %q", res.GOOS, res.GOARCH, res.Package)
+	}
+	if len(res.Benchmarks) != 2 {
+		t.Fatalf("got %d benchmarks, want 2", len(res.Benchmarks))
+	}
+
+	perm := res.lookup("BenchmarkPermutationFunction")
+	if perm == nil || perm.Procs != 4 || len(perm.Samples) != 2 {
+		t.Fatalf("got %+v for BenchmarkPermutationFunction", perm)
+	}
+	if got := perm.NsPerOp(); got[0] != 6750 || got[1] != 6770 {
+		t.Errorf("got ns/op %v, want [6750 6770]", got)
+	}
+
+	want := Sample{Iterations: 30000, NsPerOp: 52601, MBPerSec: 25.66, BytesPerOp: 512, AllocsPerOp: 2}
+	mtu := res.lookup("BenchmarkSha3_512_MTU")
+	if mtu == nil || len(mtu.Samples) != 1 || mtu.Samples[0] != want {
+		t.Errorf("got %+v for BenchmarkSha3_512_MTU, want one sample %+v", mtu, want)
+	}
+}
+
+func TestParseBenchLine(t *testing.T) {
+	for _, tc := range []struct {
+		line  string
+		name  string
+		procs int
+		ok    bool
+		err   bool
+	}{
+		{"BenchmarkA-8 100 12.5 ns/op", "BenchmarkA", 8, true, false},
+		{"BenchmarkA 100 12.5 ns/op", "BenchmarkA", 1, true, false},
+		{"BenchmarkSHA3-256-2 100 12.5 ns/op", "BenchmarkSHA3-256", 2, true, false},
+		{"BenchmarkA", "", 0, false, false},
+		{"BenchmarkA-8 100 12.5", "", 0, false, false},
+		{"BenchmarkA-8 results follow", "", 0, false, false},
+		{"BenchmarkA-8 100 fast ns/op", "", 0, false, true},
+	} {
+		name, procs, _, ok, err := parseBenchLine(tc.line)
+		if (err != nil) != tc.err || ok != tc.ok || name != tc.name || procs != tc.procs {
+			t.Errorf("parseBenchLine(%q) = %q, %d, %v, %v", tc.line, name, procs, ok, err)
+		}
+	}
+}
+
+func TestRegIncBeta(t *testing.T) {
+	for _, tc := range []struct {
+		a, b, x, want float64
+	}{
+		{1, 1, 0.3, 0.3},
+		{2, 1, 0.3, 0.09},
+		{1, 3, 0.2, 1 - 0.8*0.8*0.8},
+		{4.5, 4.5, 0.5, 0.5},
+		{3, 0.5, 0, 0},
+		{3, 0.5, 1, 1},
+	} {
+		if got := regIncBeta(tc.a, tc.b, tc.x); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("regIncBeta(%g, %g, %g) = %g, want %g", tc.a, tc.b, tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	for _, tc := range []struct {
+		a, b []float64
+		want float64
+	}{
+		// t = -2 with 8 degrees of freedom
+		{[]float64{1, 2, 3, 4, 5}, []float64{3, 4, 5, 6, 7}, 0.0805162379672},
+		{[]float64{1, 2, 3}, []float64{1, 2, 3}, 1},
+		{[]float64{5, 5}, []float64{6, 6}, 0},
+		{[]float64{5, 5}, []float64{5, 5}, 1},
+	} {
+		if got := welchTTest(tc.a, tc.b); math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("welchTTest(%v, %v) = %g, want %g", tc.a, tc.b, got, tc.want)
+		}
+	}
+	if p := welchTTest([]float64{1}, []float64{1, 2}); !math.IsNaN(p) {
+		t.Errorf("welchTTest with a single sample = %g, want NaN", p)
+	}
+}
+
+func TestPrintComparison(t *testing.T) {
+	oldRes := &ResultSet{Device: "pi3", Backend: "generic", Benchmarks: []*Benchmark{
+		{Name: "BenchmarkFast", Samples: []Sample{{NsPerOp: 100}, {NsPerOp: 102}, {NsPerOp: 98}}},
+		{Name: "BenchmarkSlow", Samples: []Sample{{NsPerOp: 100}, {NsPerOp: 101}, {NsPerOp: 99}}},
+		{Name: "BenchmarkOnlyOld", Samples: []Sample{{NsPerOp: 1}}},
+	}}
+	newRes := &ResultSet{Device: "pi3", Backend: "neon", Benchmarks: []*Benchmark{
+		{Name: "BenchmarkSlow", Samples: []Sample{{NsPerOp: 200}, {NsPerOp: 202}, {NsPerOp: 198}}},
+		{Name: "BenchmarkFast", Samples: []Sample{{NsPerOp: 50}, {NsPerOp: 51}, {NsPerOp: 49}}},
+	}}
+	var buf bytes.Buffer
+	if n := printComparison(&buf, oldRes, newRes, 0.05, 0.02); n != 1 {
+		t.Errorf("got %d regressions, want 1", n)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"pi3/generic ns/op", "pi3/neon ns/op",
+		"2.00x *", "0.50x *",
+		"geomean speedup: 1.00x over 2 benchmarks",
+		"significant regressions (p < 0.05, slowdown > 2%):\n  BenchmarkSlow\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "BenchmarkOnlyOld") {
+		t.Errorf("output contains a benchmark missing from the new set:\n%s", out)
+	}
+}
@@ -0,0 +1,60 @@
+//go:build cgo && libkeccak
+// +build cgo,libkeccak
+
+package main
+
+// Built with -tags libkeccak when the cgo wrapper has been configured by
+// CMake, so that -impl libkeccak checks libkeccak as well. The wrapper only
+// implements SHA3-512.
+
+import (
+	"hash"
+
+	keccak "github.com/anonymouse64/sha3_arm/sha3"
+)
+
+func init() {
+	implementations["libkeccak"] = implementation{
+		hashes: map[string]func() hash.Hash{
+			"SHA3-512": newLibkeccak512,
+		},
+	}
+}
+
+// libkeccak512 adapts sha3.Sha3FastHasher to hash.Hash. The wrapper can't
+// be reset and its Sum finalizes the state without appending to b, so the
+// message is buffered and hashed by a new hasher on every Sum.
+type libkeccak512 struct {
+	msg []byte
+}
+
+func newLibkeccak512() hash.Hash {
+	return new(libkeccak512)
+}
+
+func (h *libkeccak512) Write(p []byte) (int, error) {
+	h.msg = append(h.msg, p...)
+	return len(p), nil
+}
+
+func (h *libkeccak512) Sum(b []byte) []byte {
+	k := keccak.NewKeccak512()
+	if len(h.msg) > 0 {
+		if _, err := k.Write(h.msg); err != nil {
+			panic(err)
+		}
+	}
+	return append(b, k.Sum(nil)...)
+}
+
+func (h *libkeccak512) Reset() {
+	h.msg = h.msg[:0]
+}
+
+func (h *libkeccak512) Size() int {
+	return 64
+}
+
+func (h *libkeccak512) BlockSize() int {
+	return 72
+}
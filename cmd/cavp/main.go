@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"hash"
+	"log"
+	"os"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+	"github.com/anonymouse64/sha3_arm/sha3_fast/cavp"
+)
+
+// implementation is one of the hashers that can be checked, selected with
+// -impl. Algorithms missing from hashes and shakes are skipped.
+type implementation struct {
+	hashes map[string]func() hash.Hash
+	shakes map[string]func() sha3.ShakeHash
+}
+
+var implementations = map[string]implementation{
+	"sha3_fast": {
+		hashes: map[string]func() hash.Hash{
+			"SHA3-224": sha3.New224,
+			"SHA3-256": sha3.New256,
+			"SHA3-384": sha3.New384,
+			"SHA3-512": sha3.New512,
+		},
+		shakes: map[string]func() sha3.ShakeHash{
+			"SHAKE128": sha3.NewShake128,
+			"SHAKE256": sha3.NewShake256,
+		},
+	},
+}
+
+func main() {
+	implName := flag.String("impl", "sha3_fast", "implementation to check (sha3_fast, or libkeccak when built with -tags libkeccak)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: cavp [-impl name] file.rsp...\n\nChecks an implementation against NIST CAVP SHA-3 and SHAKE response files.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	impl, ok := implementations[*implName]
+	if !ok {
+		log.Fatalf("error : unknown implementation %s\n", *implName)
+	}
+
+	failed := false
+	for _, file := range flag.Args() {
+		records, err := cavp.ParseFile(file)
+		if err != nil {
+			log.Fatalf("error : %v\n", err)
+		}
+
+		alg := cavp.Algorithm(file)
+		var n int
+		if newHash, ok := impl.hashes[alg]; ok {
+			n, err = cavp.CheckHash(records, newHash)
+		} else if newShake, ok := impl.shakes[alg]; ok {
+			n, err = cavp.CheckXOF(records, func() cavp.XOF { return newShake() })
+		} else if alg != "" {
+			fmt.Printf("skip %s : %s isn't implemented by %s\n", file, alg, *implName)
+			continue
+		} else {
+			log.Fatalf("error : can't tell the algorithm from the name of %s\n", file)
+		}
+
+		if err != nil {
+			fmt.Printf("FAIL %s after %d vectors : %v\n", file, n, err)
+			failed = true
+		} else {
+			fmt.Printf("ok   %s %d vectors\n", file, n)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -2,11 +2,22 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//  +build !amd64 appengine gccgo
-
 package main
 
-import "fmt"
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/bits"
+	"os"
+	"strings"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+)
 
 // rc stores the round constants for use in the ι step.
 var rc = [24]uint64{
@@ -36,392 +47,340 @@ var rc = [24]uint64{
 	0x8000000080008008,
 }
 
-func main() {
-	var state [25]uint64
-	for i := range state {
-		state[i] = uint64(i)
+// rotc stores the rotation offsets for the ρ step, indexed by x+5y
+var rotc = [25]uint{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// The lanes of the state are indexed by x+5y throughout, the same as in
+// the sha3_fast package
+type state [25]uint64
+
+// theta computes the column parities and xors the parities of the two
+// neighbouring columns into every lane
+func theta(a *state) {
+	var c [5]uint64
+	for x := 0; x < 5; x++ {
+		c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+	}
+	for x := 0; x < 5; x++ {
+		d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+		for y := 0; y < 25; y += 5 {
+			a[x+y] ^= d
+		}
+	}
+}
+
+// thetaInverseMatrix is the inverse of the linear map on the 320 bits of
+// column parities that θ applies, stored as rows of bits. Since every column
+// has an odd number of lanes, θ maps the column parities c to c ^ d(c), so
+// inverting that recovers the original parities and from them d.
+var thetaInverseMatrix = computeThetaInverse()
+
+// computeThetaInverse builds the matrix of c -> c ^ d(c) and inverts it with
+// Gauss-Jordan elimination over GF(2)
+func computeThetaInverse() [320][5]uint64 {
+	var m, inv [320][5]uint64
+	// Column j of the matrix is the image of the j'th unit vector
+	for j := 0; j < 320; j++ {
+		var c [5]uint64
+		c[j/64] = 1 << uint(j%64)
+		for x := 0; x < 5; x++ {
+			d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+			for i := 0; i < 64; i++ {
+				if (c[x]^d)>>uint(i)&1 == 1 {
+					m[x*64+i][j/64] |= 1 << uint(j%64)
+				}
+			}
+		}
+		inv[j][j/64] = 1 << uint(j%64)
+	}
+
+	for col := 0; col < 320; col++ {
+		pivot := col
+		for m[pivot][col/64]>>uint(col%64)&1 == 0 {
+			pivot++
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		inv[col], inv[pivot] = inv[pivot], inv[col]
+		for row := 0; row < 320; row++ {
+			if row != col && m[row][col/64]>>uint(col%64)&1 == 1 {
+				for k := 0; k < 5; k++ {
+					m[row][k] ^= m[col][k]
+					inv[row][k] ^= inv[col][k]
+				}
+			}
+		}
+	}
+	return inv
+}
+
+// thetaInverse undoes the θ step
+func thetaInverse(a *state) {
+	var cAfter, c [5]uint64
+	for x := 0; x < 5; x++ {
+		cAfter[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+	}
+	for row := 0; row < 320; row++ {
+		var parity uint64
+		for k := 0; k < 5; k++ {
+			parity ^= thetaInverseMatrix[row][k] & cAfter[k]
+		}
+		c[row/64] |= uint64(bits.OnesCount64(parity)&1) << uint(row%64)
+	}
+	for x := 0; x < 5; x++ {
+		d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+		for y := 0; y < 25; y += 5 {
+			a[x+y] ^= d
+		}
+	}
+}
+
+// rho rotates every lane by its offset
+func rho(a *state) {
+	for i := range a {
+		a[i] = bits.RotateLeft64(a[i], int(rotc[i]))
+	}
+}
+
+// rhoInverse undoes the ρ step
+func rhoInverse(a *state) {
+	for i := range a {
+		a[i] = bits.RotateLeft64(a[i], -int(rotc[i]))
+	}
+}
+
+// pi moves the lane at (x, y) to (y, 2x+3y)
+func pi(a *state) {
+	var b state
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			b[y+5*((2*x+3*y)%5)] = a[x+5*y]
+		}
+	}
+	*a = b
+}
+
+// piInverse undoes the π step
+func piInverse(a *state) {
+	var b state
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			b[x+5*y] = a[y+5*((2*x+3*y)%5)]
+		}
+	}
+	*a = b
+}
+
+// chi applies the non-linear step along each row
+func chi(a *state) {
+	for y := 0; y < 25; y += 5 {
+		var row [5]uint64
+		copy(row[:], a[y:y+5])
+		for x := 0; x < 5; x++ {
+			a[y+x] = row[x] ^ (^row[(x+1)%5] & row[(x+2)%5])
+		}
+	}
+}
+
+// chiInverseTable maps each 5-bit row slice back to its preimage under χ
+var chiInverseTable = computeChiInverse()
+
+func computeChiInverse() [32]uint8 {
+	var inv [32]uint8
+	for in := uint(0); in < 32; in++ {
+		var out uint
+		for x := uint(0); x < 5; x++ {
+			bit := in>>x ^ (^(in >> ((x + 1) % 5)) & (in >> ((x + 2) % 5)))
+			out |= (bit & 1) << x
+		}
+		inv[out] = uint8(in)
+	}
+	return inv
+}
+
+// chiInverse undoes the χ step one 5-bit row slice at a time
+func chiInverse(a *state) {
+	for y := 0; y < 25; y += 5 {
+		var row [5]uint64
+		for z := uint(0); z < 64; z++ {
+			var slice uint8
+			for x := uint(0); x < 5; x++ {
+				slice |= uint8(a[y+int(x)]>>z&1) << x
+			}
+			pre := chiInverseTable[slice]
+			for x := uint(0); x < 5; x++ {
+				row[x] |= uint64(pre>>x&1) << z
+			}
+		}
+		copy(a[y:y+5], row[:])
+	}
+}
+
+// step is a single named step of a round, the round number is needed for ι
+type step struct {
+	name string
+	f    func(a *state, round int)
+}
+
+var forwardSteps = []step{
+	{"θ", func(a *state, round int) { theta(a) }},
+	{"ρ", func(a *state, round int) { rho(a) }},
+	{"π", func(a *state, round int) { pi(a) }},
+	{"χ", func(a *state, round int) { chi(a) }},
+	{"ι", func(a *state, round int) { a[0] ^= rc[round] }},
+}
+
+var inverseSteps = []step{
+	{"ι⁻¹", func(a *state, round int) { a[0] ^= rc[round] }},
+	{"χ⁻¹", func(a *state, round int) { chiInverse(a) }},
+	{"π⁻¹", func(a *state, round int) { piInverse(a) }},
+	{"ρ⁻¹", func(a *state, round int) { rhoInverse(a) }},
+	{"θ⁻¹", func(a *state, round int) { thetaInverse(a) }},
+}
+
+// printGrid prints the state as a 5x5 grid of lanes in hex, with x
+// increasing to the right and y increasing downwards
+func printGrid(w io.Writer, title string, a *state) {
+	fmt.Fprintf(w, "%s\n", title)
+	for y := 0; y < 5; y++ {
+		fmt.Fprintf(w, "  ")
+		for x := 0; x < 5; x++ {
+			fmt.Fprintf(w, " %016x", a[x+5*y])
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// permute runs the given rounds of the permutation (or its inverse) on a
+// with the reference step by step implementation, printing the state after
+// every step if verbose is set
+func permute(a *state, start, rounds int, inverse, verbose bool) {
+	for i := 0; i < rounds; i++ {
+		round, steps := start+i, forwardSteps
+		if inverse {
+			// The inverse runs the rounds backwards starting from the last one
+			round, steps = start+rounds-1-i, inverseSteps
+		}
+		for _, s := range steps {
+			s.f(a, round)
+			if verbose {
+				printGrid(os.Stdout, fmt.Sprintf("round %d after %s", round, s.name), a)
+			}
+		}
 	}
+}
 
-	keccakF1600Generic(&state)
+// parseState decodes a hex encoded state of up to 200 bytes, which are
+// loaded into the lanes little endian the same way the sponge xors in
+// input. Whitespace is ignored and missing bytes are zero.
+func parseState(s string) (*state, error) {
+	s = strings.Join(strings.Fields(s), "")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > 200 {
+		return nil, fmt.Errorf("state is %d bytes, at most 200 allowed", len(b))
+	}
+	var buf [200]byte
+	copy(buf[:], b)
+	var a state
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(buf[8*i:])
+	}
+	return &a, nil
+}
 
-	for i, val := range state {
-		fmt.Printf("state[%d] = %v\n", i, val)
+// encodeState is the inverse of parseState
+func encodeState(a *state) string {
+	var buf [200]byte
+	for i := range a {
+		binary.LittleEndian.PutUint64(buf[8*i:], a[i])
 	}
+	return hex.EncodeToString(buf[:])
 }
 
-// keccakF1600Generic applies the Keccak permutation to a 1600b-wide
-// state represented as a slice of 25 uint64s.
-func keccakF1600Generic(a *[25]uint64) {
-	// Implementation translated from Keccak-inplace.c
-	// in the keccak reference code.
-	var t, bc0, bc1, bc2, bc3, bc4, d0, d1, d2, d3, d4 uint64
-
-	for i := 0; i < 24; i += 4 {
-		// Combines the 5 steps in each round into 2 steps.
-		// Unrolls 4 rounds per loop and spreads some steps across rounds.
-
-		// Round 1
-		bc0 = a[0] ^ a[5] ^ a[10] ^ a[15] ^ a[20]
-		bc1 = a[1] ^ a[6] ^ a[11] ^ a[16] ^ a[21]
-		bc2 = a[2] ^ a[7] ^ a[12] ^ a[17] ^ a[22]
-		bc3 = a[3] ^ a[8] ^ a[13] ^ a[18] ^ a[23]
-		bc4 = a[4] ^ a[9] ^ a[14] ^ a[19] ^ a[24]
-		d0 = bc4 ^ (bc1<<1 | bc1>>63)
-		d1 = bc0 ^ (bc2<<1 | bc2>>63)
-		d2 = bc1 ^ (bc3<<1 | bc3>>63)
-		d3 = bc2 ^ (bc4<<1 | bc4>>63)
-		d4 = bc3 ^ (bc0<<1 | bc0>>63)
-
-		bc0 = a[0] ^ d0
-		t = a[6] ^ d1
-		bc1 = t<<44 | t>>(64-44)
-		t = a[12] ^ d2
-		bc2 = t<<43 | t>>(64-43)
-		t = a[18] ^ d3
-		bc3 = t<<21 | t>>(64-21)
-		t = a[24] ^ d4
-		bc4 = t<<14 | t>>(64-14)
-		a[0] = bc0 ^ (bc2 &^ bc1) ^ rc[i]
-		a[6] = bc1 ^ (bc3 &^ bc2)
-		a[12] = bc2 ^ (bc4 &^ bc3)
-		a[18] = bc3 ^ (bc0 &^ bc4)
-		a[24] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[10] ^ d0
-		bc2 = t<<3 | t>>(64-3)
-		t = a[16] ^ d1
-		bc3 = t<<45 | t>>(64-45)
-		t = a[22] ^ d2
-		bc4 = t<<61 | t>>(64-61)
-		t = a[3] ^ d3
-		bc0 = t<<28 | t>>(64-28)
-		t = a[9] ^ d4
-		bc1 = t<<20 | t>>(64-20)
-		a[10] = bc0 ^ (bc2 &^ bc1)
-		a[16] = bc1 ^ (bc3 &^ bc2)
-		a[22] = bc2 ^ (bc4 &^ bc3)
-		a[3] = bc3 ^ (bc0 &^ bc4)
-		a[9] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[20] ^ d0
-		bc4 = t<<18 | t>>(64-18)
-		t = a[1] ^ d1
-		bc0 = t<<1 | t>>(64-1)
-		t = a[7] ^ d2
-		bc1 = t<<6 | t>>(64-6)
-		t = a[13] ^ d3
-		bc2 = t<<25 | t>>(64-25)
-		t = a[19] ^ d4
-		bc3 = t<<8 | t>>(64-8)
-		a[20] = bc0 ^ (bc2 &^ bc1)
-		a[1] = bc1 ^ (bc3 &^ bc2)
-		a[7] = bc2 ^ (bc4 &^ bc3)
-		a[13] = bc3 ^ (bc0 &^ bc4)
-		a[19] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[5] ^ d0
-		bc1 = t<<36 | t>>(64-36)
-		t = a[11] ^ d1
-		bc2 = t<<10 | t>>(64-10)
-		t = a[17] ^ d2
-		bc3 = t<<15 | t>>(64-15)
-		t = a[23] ^ d3
-		bc4 = t<<56 | t>>(64-56)
-		t = a[4] ^ d4
-		bc0 = t<<27 | t>>(64-27)
-		a[5] = bc0 ^ (bc2 &^ bc1)
-		a[11] = bc1 ^ (bc3 &^ bc2)
-		a[17] = bc2 ^ (bc4 &^ bc3)
-		a[23] = bc3 ^ (bc0 &^ bc4)
-		a[4] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[15] ^ d0
-		bc3 = t<<41 | t>>(64-41)
-		t = a[21] ^ d1
-		bc4 = t<<2 | t>>(64-2)
-		t = a[2] ^ d2
-		bc0 = t<<62 | t>>(64-62)
-		t = a[8] ^ d3
-		bc1 = t<<55 | t>>(64-55)
-		t = a[14] ^ d4
-		bc2 = t<<39 | t>>(64-39)
-		a[15] = bc0 ^ (bc2 &^ bc1)
-		a[21] = bc1 ^ (bc3 &^ bc2)
-		a[2] = bc2 ^ (bc4 &^ bc3)
-		a[8] = bc3 ^ (bc0 &^ bc4)
-		a[14] = bc4 ^ (bc1 &^ bc0)
-
-		// Round 2
-		bc0 = a[0] ^ a[5] ^ a[10] ^ a[15] ^ a[20]
-		bc1 = a[1] ^ a[6] ^ a[11] ^ a[16] ^ a[21]
-		bc2 = a[2] ^ a[7] ^ a[12] ^ a[17] ^ a[22]
-		bc3 = a[3] ^ a[8] ^ a[13] ^ a[18] ^ a[23]
-		bc4 = a[4] ^ a[9] ^ a[14] ^ a[19] ^ a[24]
-		d0 = bc4 ^ (bc1<<1 | bc1>>63)
-		d1 = bc0 ^ (bc2<<1 | bc2>>63)
-		d2 = bc1 ^ (bc3<<1 | bc3>>63)
-		d3 = bc2 ^ (bc4<<1 | bc4>>63)
-		d4 = bc3 ^ (bc0<<1 | bc0>>63)
-
-		bc0 = a[0] ^ d0
-		t = a[16] ^ d1
-		bc1 = t<<44 | t>>(64-44)
-		t = a[7] ^ d2
-		bc2 = t<<43 | t>>(64-43)
-		t = a[23] ^ d3
-		bc3 = t<<21 | t>>(64-21)
-		t = a[14] ^ d4
-		bc4 = t<<14 | t>>(64-14)
-		a[0] = bc0 ^ (bc2 &^ bc1) ^ rc[i+1]
-		a[16] = bc1 ^ (bc3 &^ bc2)
-		a[7] = bc2 ^ (bc4 &^ bc3)
-		a[23] = bc3 ^ (bc0 &^ bc4)
-		a[14] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[20] ^ d0
-		bc2 = t<<3 | t>>(64-3)
-		t = a[11] ^ d1
-		bc3 = t<<45 | t>>(64-45)
-		t = a[2] ^ d2
-		bc4 = t<<61 | t>>(64-61)
-		t = a[18] ^ d3
-		bc0 = t<<28 | t>>(64-28)
-		t = a[9] ^ d4
-		bc1 = t<<20 | t>>(64-20)
-		a[20] = bc0 ^ (bc2 &^ bc1)
-		a[11] = bc1 ^ (bc3 &^ bc2)
-		a[2] = bc2 ^ (bc4 &^ bc3)
-		a[18] = bc3 ^ (bc0 &^ bc4)
-		a[9] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[15] ^ d0
-		bc4 = t<<18 | t>>(64-18)
-		t = a[6] ^ d1
-		bc0 = t<<1 | t>>(64-1)
-		t = a[22] ^ d2
-		bc1 = t<<6 | t>>(64-6)
-		t = a[13] ^ d3
-		bc2 = t<<25 | t>>(64-25)
-		t = a[4] ^ d4
-		bc3 = t<<8 | t>>(64-8)
-		a[15] = bc0 ^ (bc2 &^ bc1)
-		a[6] = bc1 ^ (bc3 &^ bc2)
-		a[22] = bc2 ^ (bc4 &^ bc3)
-		a[13] = bc3 ^ (bc0 &^ bc4)
-		a[4] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[10] ^ d0
-		bc1 = t<<36 | t>>(64-36)
-		t = a[1] ^ d1
-		bc2 = t<<10 | t>>(64-10)
-		t = a[17] ^ d2
-		bc3 = t<<15 | t>>(64-15)
-		t = a[8] ^ d3
-		bc4 = t<<56 | t>>(64-56)
-		t = a[24] ^ d4
-		bc0 = t<<27 | t>>(64-27)
-		a[10] = bc0 ^ (bc2 &^ bc1)
-		a[1] = bc1 ^ (bc3 &^ bc2)
-		a[17] = bc2 ^ (bc4 &^ bc3)
-		a[8] = bc3 ^ (bc0 &^ bc4)
-		a[24] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[5] ^ d0
-		bc3 = t<<41 | t>>(64-41)
-		t = a[21] ^ d1
-		bc4 = t<<2 | t>>(64-2)
-		t = a[12] ^ d2
-		bc0 = t<<62 | t>>(64-62)
-		t = a[3] ^ d3
-		bc1 = t<<55 | t>>(64-55)
-		t = a[19] ^ d4
-		bc2 = t<<39 | t>>(64-39)
-		a[5] = bc0 ^ (bc2 &^ bc1)
-		a[21] = bc1 ^ (bc3 &^ bc2)
-		a[12] = bc2 ^ (bc4 &^ bc3)
-		a[3] = bc3 ^ (bc0 &^ bc4)
-		a[19] = bc4 ^ (bc1 &^ bc0)
-
-		// Round 3
-		bc0 = a[0] ^ a[5] ^ a[10] ^ a[15] ^ a[20]
-		bc1 = a[1] ^ a[6] ^ a[11] ^ a[16] ^ a[21]
-		bc2 = a[2] ^ a[7] ^ a[12] ^ a[17] ^ a[22]
-		bc3 = a[3] ^ a[8] ^ a[13] ^ a[18] ^ a[23]
-		bc4 = a[4] ^ a[9] ^ a[14] ^ a[19] ^ a[24]
-		d0 = bc4 ^ (bc1<<1 | bc1>>63)
-		d1 = bc0 ^ (bc2<<1 | bc2>>63)
-		d2 = bc1 ^ (bc3<<1 | bc3>>63)
-		d3 = bc2 ^ (bc4<<1 | bc4>>63)
-		d4 = bc3 ^ (bc0<<1 | bc0>>63)
-
-		bc0 = a[0] ^ d0
-		t = a[11] ^ d1
-		bc1 = t<<44 | t>>(64-44)
-		t = a[22] ^ d2
-		bc2 = t<<43 | t>>(64-43)
-		t = a[8] ^ d3
-		bc3 = t<<21 | t>>(64-21)
-		t = a[19] ^ d4
-		bc4 = t<<14 | t>>(64-14)
-		a[0] = bc0 ^ (bc2 &^ bc1) ^ rc[i+2]
-		a[11] = bc1 ^ (bc3 &^ bc2)
-		a[22] = bc2 ^ (bc4 &^ bc3)
-		a[8] = bc3 ^ (bc0 &^ bc4)
-		a[19] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[15] ^ d0
-		bc2 = t<<3 | t>>(64-3)
-		t = a[1] ^ d1
-		bc3 = t<<45 | t>>(64-45)
-		t = a[12] ^ d2
-		bc4 = t<<61 | t>>(64-61)
-		t = a[23] ^ d3
-		bc0 = t<<28 | t>>(64-28)
-		t = a[9] ^ d4
-		bc1 = t<<20 | t>>(64-20)
-		a[15] = bc0 ^ (bc2 &^ bc1)
-		a[1] = bc1 ^ (bc3 &^ bc2)
-		a[12] = bc2 ^ (bc4 &^ bc3)
-		a[23] = bc3 ^ (bc0 &^ bc4)
-		a[9] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[5] ^ d0
-		bc4 = t<<18 | t>>(64-18)
-		t = a[16] ^ d1
-		bc0 = t<<1 | t>>(64-1)
-		t = a[2] ^ d2
-		bc1 = t<<6 | t>>(64-6)
-		t = a[13] ^ d3
-		bc2 = t<<25 | t>>(64-25)
-		t = a[24] ^ d4
-		bc3 = t<<8 | t>>(64-8)
-		a[5] = bc0 ^ (bc2 &^ bc1)
-		a[16] = bc1 ^ (bc3 &^ bc2)
-		a[2] = bc2 ^ (bc4 &^ bc3)
-		a[13] = bc3 ^ (bc0 &^ bc4)
-		a[24] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[20] ^ d0
-		bc1 = t<<36 | t>>(64-36)
-		t = a[6] ^ d1
-		bc2 = t<<10 | t>>(64-10)
-		t = a[17] ^ d2
-		bc3 = t<<15 | t>>(64-15)
-		t = a[3] ^ d3
-		bc4 = t<<56 | t>>(64-56)
-		t = a[14] ^ d4
-		bc0 = t<<27 | t>>(64-27)
-		a[20] = bc0 ^ (bc2 &^ bc1)
-		a[6] = bc1 ^ (bc3 &^ bc2)
-		a[17] = bc2 ^ (bc4 &^ bc3)
-		a[3] = bc3 ^ (bc0 &^ bc4)
-		a[14] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[10] ^ d0
-		bc3 = t<<41 | t>>(64-41)
-		t = a[21] ^ d1
-		bc4 = t<<2 | t>>(64-2)
-		t = a[7] ^ d2
-		bc0 = t<<62 | t>>(64-62)
-		t = a[18] ^ d3
-		bc1 = t<<55 | t>>(64-55)
-		t = a[4] ^ d4
-		bc2 = t<<39 | t>>(64-39)
-		a[10] = bc0 ^ (bc2 &^ bc1)
-		a[21] = bc1 ^ (bc3 &^ bc2)
-		a[7] = bc2 ^ (bc4 &^ bc3)
-		a[18] = bc3 ^ (bc0 &^ bc4)
-		a[4] = bc4 ^ (bc1 &^ bc0)
-
-		// Round 4
-		bc0 = a[0] ^ a[5] ^ a[10] ^ a[15] ^ a[20]
-		bc1 = a[1] ^ a[6] ^ a[11] ^ a[16] ^ a[21]
-		bc2 = a[2] ^ a[7] ^ a[12] ^ a[17] ^ a[22]
-		bc3 = a[3] ^ a[8] ^ a[13] ^ a[18] ^ a[23]
-		bc4 = a[4] ^ a[9] ^ a[14] ^ a[19] ^ a[24]
-		d0 = bc4 ^ (bc1<<1 | bc1>>63)
-		d1 = bc0 ^ (bc2<<1 | bc2>>63)
-		d2 = bc1 ^ (bc3<<1 | bc3>>63)
-		d3 = bc2 ^ (bc4<<1 | bc4>>63)
-		d4 = bc3 ^ (bc0<<1 | bc0>>63)
-
-		bc0 = a[0] ^ d0
-		t = a[1] ^ d1
-		bc1 = t<<44 | t>>(64-44)
-		t = a[2] ^ d2
-		bc2 = t<<43 | t>>(64-43)
-		t = a[3] ^ d3
-		bc3 = t<<21 | t>>(64-21)
-		t = a[4] ^ d4
-		bc4 = t<<14 | t>>(64-14)
-		a[0] = bc0 ^ (bc2 &^ bc1) ^ rc[i+3]
-		a[1] = bc1 ^ (bc3 &^ bc2)
-		a[2] = bc2 ^ (bc4 &^ bc3)
-		a[3] = bc3 ^ (bc0 &^ bc4)
-		a[4] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[5] ^ d0
-		bc2 = t<<3 | t>>(64-3)
-		t = a[6] ^ d1
-		bc3 = t<<45 | t>>(64-45)
-		t = a[7] ^ d2
-		bc4 = t<<61 | t>>(64-61)
-		t = a[8] ^ d3
-		bc0 = t<<28 | t>>(64-28)
-		t = a[9] ^ d4
-		bc1 = t<<20 | t>>(64-20)
-		a[5] = bc0 ^ (bc2 &^ bc1)
-		a[6] = bc1 ^ (bc3 &^ bc2)
-		a[7] = bc2 ^ (bc4 &^ bc3)
-		a[8] = bc3 ^ (bc0 &^ bc4)
-		a[9] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[10] ^ d0
-		bc4 = t<<18 | t>>(64-18)
-		t = a[11] ^ d1
-		bc0 = t<<1 | t>>(64-1)
-		t = a[12] ^ d2
-		bc1 = t<<6 | t>>(64-6)
-		t = a[13] ^ d3
-		bc2 = t<<25 | t>>(64-25)
-		t = a[14] ^ d4
-		bc3 = t<<8 | t>>(64-8)
-		a[10] = bc0 ^ (bc2 &^ bc1)
-		a[11] = bc1 ^ (bc3 &^ bc2)
-		a[12] = bc2 ^ (bc4 &^ bc3)
-		a[13] = bc3 ^ (bc0 &^ bc4)
-		a[14] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[15] ^ d0
-		bc1 = t<<36 | t>>(64-36)
-		t = a[16] ^ d1
-		bc2 = t<<10 | t>>(64-10)
-		t = a[17] ^ d2
-		bc3 = t<<15 | t>>(64-15)
-		t = a[18] ^ d3
-		bc4 = t<<56 | t>>(64-56)
-		t = a[19] ^ d4
-		bc0 = t<<27 | t>>(64-27)
-		a[15] = bc0 ^ (bc2 &^ bc1)
-		a[16] = bc1 ^ (bc3 &^ bc2)
-		a[17] = bc2 ^ (bc4 &^ bc3)
-		a[18] = bc3 ^ (bc0 &^ bc4)
-		a[19] = bc4 ^ (bc1 &^ bc0)
-
-		t = a[20] ^ d0
-		bc3 = t<<41 | t>>(64-41)
-		t = a[21] ^ d1
-		bc4 = t<<2 | t>>(64-2)
-		t = a[22] ^ d2
-		bc0 = t<<62 | t>>(64-62)
-		t = a[23] ^ d3
-		bc1 = t<<55 | t>>(64-55)
-		t = a[24] ^ d4
-		bc2 = t<<39 | t>>(64-39)
-		a[20] = bc0 ^ (bc2 &^ bc1)
-		a[21] = bc1 ^ (bc3 &^ bc2)
-		a[22] = bc2 ^ (bc4 &^ bc3)
-		a[23] = bc3 ^ (bc0 &^ bc4)
-		a[24] = bc4 ^ (bc1 &^ bc0)
+func main() {
+	stateStr := flag.String("state", "", "initial state as up to 200 hex encoded bytes, or - to read it from stdin (default lanes 0..24)")
+	rounds := flag.Int("rounds", 24, "number of rounds to apply")
+	start := flag.Int("start", 0, "index of the first round, which selects the round constants used")
+	inverse := flag.Bool("inverse", false, "apply the inverse permutation, undoing rounds start+rounds-1 down to start")
+	verbose := flag.Bool("steps", false, "print the state after every step of every round")
+	backend := flag.String("backend", "reference", fmt.Sprintf("implementation to compute the result with, reference, all or one of %s", strings.Join(sha3.PermutationBackends(), ", ")))
+
+	flag.Parse()
+
+	if *rounds < 0 || *start < 0 || *start+*rounds > len(rc) {
+		log.Fatalf("error : rounds %d to %d out of range 0 to %d\n", *start, *start+*rounds-1, len(rc)-1)
+	}
+
+	// The backends only implement the full forward permutation
+	if *backend != "reference" && (*inverse || *start != 0 || *rounds != len(rc)) {
+		log.Fatalf("error : backend %s only supports the full forward permutation\n", *backend)
+	}
+
+	// Read the initial state
+	var a *state
+	switch *stateStr {
+	case "":
+		a = &state{}
+		for i := range a {
+			a[i] = uint64(i)
+		}
+	case "-":
+		in, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatalf("error : %v\n", err)
+		}
+		if a, err = parseState(string(in)); err != nil {
+			log.Fatalf("error : invalid state : %v\n", err)
+		}
+	default:
+		var err error
+		if a, err = parseState(*stateStr); err != nil {
+			log.Fatalf("error : invalid state : %v\n", err)
+		}
+	}
+
+	printGrid(os.Stdout, "initial state", a)
+
+	// Compute the reference result, this is also what gets checked against
+	// when cross-checking the backends
+	ref := *a
+	permute(&ref, *start, *rounds, *inverse, *verbose)
+	result := ref
+
+	if *backend != "reference" {
+		backends := []string{*backend}
+		if *backend == "all" {
+			backends = sha3.PermutationBackends()
+		}
+		mismatch := false
+		for _, name := range backends {
+			res := [25]uint64(*a)
+			if err := sha3.KeccakF1600With(name, &res); err != nil {
+				log.Fatalf("error : %v\n", err)
+			}
+			if state(res) != ref {
+				fmt.Printf("backend %s does not match the reference implementation\n", name)
+				printGrid(os.Stdout, fmt.Sprintf("%s result", name), (*state)(&res))
+				mismatch = true
+			} else {
+				fmt.Printf("backend %s matches the reference implementation\n", name)
+			}
+			result = state(res)
+		}
+		if mismatch {
+			printGrid(os.Stdout, "reference result", &ref)
+			os.Exit(1)
+		}
 	}
+
+	printGrid(os.Stdout, "final state", &result)
+	fmt.Printf("state: %s\n", encodeState(&result))
 }
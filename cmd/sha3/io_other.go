@@ -0,0 +1,31 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+)
+
+// directIOFlag is unused, as O_DIRECT is only supported on Linux
+const directIOFlag = 0
+
+// checkIOMode returns an error if ioMode isn't one of the ways of reading
+// files that FileDigest supports, which is only read outside of Linux
+func checkIOMode(ioMode string) error {
+	switch ioMode {
+	case "read":
+		return nil
+	case "direct", "mmap":
+		return fmt.Errorf("io mode %s is only supported on linux", ioMode)
+	}
+	return fmt.Errorf("invalid io mode %s", ioMode)
+}
+
+// mmapDigest is never called, as checkIOMode rejects the mmap mode
+func mmapDigest(f *os.File, hash hash.Hash, times *digestTimes) (uint64, error) {
+	return 0, errors.New("io mode mmap is only supported on linux")
+}
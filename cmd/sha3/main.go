@@ -1,16 +1,18 @@
 package main
 
 import (
+	"bufio"
 	"crypto/rand"
+	"encoding/hex"
 	"flag"
 	"fmt"
 	"hash"
 	"io"
-	"io/ioutil"
 	"log"
 	"os"
 	"strings"
 	"time"
+	"unsafe"
 
 	// osutil "github.com/snapcore/snapd/osutil"
 	// _ "golang.org/x/crypto/sha3"
@@ -21,39 +23,274 @@ const (
 	hashDigestBufSize = 2 * 1024 * 1024
 )
 
-// FileDigest computes a hash digest of the file using the given hash.
-// It also returns the file size.
-func FileDigest(filename string, hash hash.Hash) ([]byte, uint64, error) {
-	f, err := os.Open(filename)
+// digestTimes records how long was spent getting the data to hash and how
+// long was spent in the hash itself
+type digestTimes struct {
+	io   time.Duration
+	hash time.Duration
+}
+
+// FileDigest computes a hash digest of the file using the given hash and
+// method of reading the file, which is one of :
+//
+//	read   - read(2) into a buffer
+//	direct - read(2) into an aligned buffer with O_DIRECT to bypass the page cache
+//	mmap   - mmap(2) the file and hash directly from the mapping
+//
+// The direct and mmap methods are only supported on Linux.
+//
+// It also returns the file size and the time spent on I/O and on hashing.
+func FileDigest(filename string, hash hash.Hash, ioMode string) ([]byte, uint64, digestTimes, error) {
+	var times digestTimes
+	if err := checkIOMode(ioMode); err != nil {
+		return nil, 0, times, err
+	}
+	flags := os.O_RDONLY
+	if ioMode == "direct" {
+		flags |= directIOFlag
+	}
+	start := time.Now()
+	f, err := os.OpenFile(filename, flags, 0)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, times, err
 	}
 	defer f.Close()
-	// h := hash.New()
-	size, err := io.CopyBuffer(hash, f, make([]byte, hashDigestBufSize))
+	times.io += time.Since(start)
+
+	var size uint64
+	switch ioMode {
+	case "read":
+		size, err = readDigest(f, hash, make([]byte, hashDigestBufSize), &times)
+	case "direct":
+		size, err = readDigest(f, hash, alignedBuffer(hashDigestBufSize, directIOAlignment), &times)
+	case "mmap":
+		size, err = mmapDigest(f, hash, &times)
+	default:
+		err = fmt.Errorf("invalid io mode %s", ioMode)
+	}
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, times, err
+	}
+
+	start = time.Now()
+	sum := hash.Sum(nil)
+	times.hash += time.Since(start)
+	return sum, size, times, nil
+}
+
+const (
+	// directIOAlignment is the alignment of the buffer and the read sizes
+	// required by O_DIRECT, this is the logical block size on most devices
+	// but 4096 is always safe
+	directIOAlignment = 4096
+)
+
+// alignedBuffer returns a buffer of size bytes whose address is aligned to
+// align bytes
+func alignedBuffer(size, align int) []byte {
+	buf := make([]byte, size+align)
+	off := 0
+	if rem := int(uintptr(unsafe.Pointer(&buf[0])) & uintptr(align-1)); rem != 0 {
+		off = align - rem
+	}
+	return buf[off : off+size]
+}
+
+// readDigest hashes f by reading it into buf, keeping track of the time spent
+// reading and hashing separately
+func readDigest(f *os.File, hash hash.Hash, buf []byte, times *digestTimes) (uint64, error) {
+	var size uint64
+	for {
+		start := time.Now()
+		n, err := f.Read(buf)
+		times.io += time.Since(start)
+		if n > 0 {
+			start = time.Now()
+			hash.Write(buf[:n])
+			times.hash += time.Since(start)
+			size += uint64(n)
+		}
+		if err == io.EOF {
+			return size, nil
+		}
+		if err != nil {
+			return size, err
+		}
+	}
+}
+
+// ShakeStream absorbs everything from r into the SHAKE hash, then squeezes
+// length bytes of output into w. The output is generated in chunks so that
+// arbitrarily large lengths never need to be held in memory.
+func ShakeStream(h sha3.ShakeHash, r io.Reader, w io.Writer, length uint64) error {
+	buf := make([]byte, hashDigestBufSize)
+	if _, err := io.CopyBuffer(h, r, buf); err != nil {
+		return err
+	}
+	for length > 0 {
+		chunk := buf
+		if length < uint64(len(chunk)) {
+			chunk = chunk[:length]
+		}
+		h.Read(chunk)
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+		length -= uint64(len(chunk))
 	}
-	return hash.Sum(nil), uint64(size), nil
+	return nil
 }
 
-func timeFileHash(hasher hash.Hash, file string) ([]byte, time.Duration) {
+// shakeOutput writes length bytes of SHAKE output of the input file (or
+// stdin if no file is specified) to the output file (or stdout), as hex
+// unless raw is set
+func shakeOutput(h sha3.ShakeHash, inFile, outFile string, length uint64, raw bool) error {
+	in := os.Stdin
+	if inFile != "" {
+		f, err := os.Open(inFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	out := os.Stdout
+	if outFile != "" {
+		f, err := os.Create(outFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	bufOut := bufio.NewWriterSize(out, hashDigestBufSize)
+	var w io.Writer = bufOut
+	if !raw {
+		w = hex.NewEncoder(bufOut)
+	}
+	if err := ShakeStream(h, in, w, length); err != nil {
+		return err
+	}
+	if !raw {
+		bufOut.WriteString("\n")
+	}
+	if err := bufOut.Flush(); err != nil {
+		return err
+	}
+	// Make sure any errors writing the file are reported
+	if outFile != "" {
+		return out.Close()
+	}
+	return nil
+}
+
+func timeFileHash(hasher hash.Hash, file string, ioMode string) ([]byte, time.Duration, digestTimes, error) {
 	// Get start time
 	start := time.Now()
 
 	// Compute the hash of the file
-	hashRes, _, _ := FileDigest(file, hasher)
+	hashRes, _, times, err := FileDigest(file, hasher, ioMode)
 
 	// Return the hash result and the time since the start of the function
-	return hashRes, time.Since(start)
+	return hashRes, time.Since(start), times, err
+}
+
+// randomSource returns a reader of the data to hash when no file is given.
+// Without a seed this is the OS's random number generator, otherwise it's the
+// SHAKE256 output of the seed so that runs are reproducible.
+func randomSource(seed string) io.Reader {
+	if seed == "" {
+		return rand.Reader
+	}
+	h := sha3.NewShake256()
+	h.Write([]byte(seed))
+	return h
+}
+
+// StreamDigest hashes size bytes read from r in chunks, also writing them to
+// tee if it isn't nil. It returns the digest and the time spent in the hash,
+// with the time to generate and write out the data counted as I/O time.
+func StreamDigest(r io.Reader, size int64, hash hash.Hash, tee io.Writer) ([]byte, digestTimes, error) {
+	var times digestTimes
+	buf := make([]byte, hashDigestBufSize)
+	for size > 0 {
+		chunk := buf
+		if size < int64(len(chunk)) {
+			chunk = chunk[:size]
+		}
+		start := time.Now()
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return nil, times, err
+		}
+		times.io += time.Since(start)
+
+		start = time.Now()
+		hash.Write(chunk)
+		times.hash += time.Since(start)
+
+		if tee != nil {
+			start = time.Now()
+			if _, err := tee.Write(chunk); err != nil {
+				return nil, times, err
+			}
+			times.io += time.Since(start)
+		}
+		size -= int64(len(chunk))
+	}
+
+	start := time.Now()
+	sum := hash.Sum(nil)
+	times.hash += time.Since(start)
+	return sum, times, nil
+}
+
+func timeStreamHash(hasher hash.Hash, r io.Reader, size int64, teeFile string) ([]byte, time.Duration, digestTimes, error) {
+	start := time.Now()
+
+	// Recreate the tee file each time so that it always matches the most
+	// recent hash
+	if teeFile == "" {
+		hashRes, times, err := StreamDigest(r, size, hasher, nil)
+		return hashRes, time.Since(start), times, err
+	}
+
+	f, err := os.Create(teeFile)
+	if err != nil {
+		return nil, 0, digestTimes{}, err
+	}
+	bufTee := bufio.NewWriterSize(f, hashDigestBufSize)
+	hashRes, times, err := StreamDigest(r, size, hasher, bufTee)
+	if err != nil {
+		f.Close()
+		return nil, 0, times, err
+	}
+	// Make sure any errors writing the tee file are reported
+	flushStart := time.Now()
+	if err := bufTee.Flush(); err != nil {
+		f.Close()
+		return nil, 0, times, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, 0, times, err
+	}
+	times.io += time.Since(flushStart)
+	return hashRes, time.Since(start), times, nil
 }
 
 func main() {
 	// Setup flags
 	fileStr := flag.String("file", "", "file to hash")
-	randomSizeMB := flag.Int64("size", 10, "size of generated random file")
+	randomSizeMB := flag.Int64("size", 10, "size of random data to hash in MB when no file is given")
+	seedStr := flag.String("seed", "", "seed for reproducible random data generated with shake256, instead of the OS random number generator")
+	teeStr := flag.String("tee", "", "file to also write the generated random data to")
 	unitStr := flag.String("unit", "s", "units to use (possible values : ns, us, ms, s)")
-	// algStr := flag.String("alg", "sha3_512", "algorithm to use, sha3_512, sha3_384, sha3_256, or sha3_224")
+	algStr := flag.String("a", "sha3_512", "algorithm to use, sha3_512, sha3_384, sha3_256, sha3_224, shake128 or shake256")
+	outLength := flag.Uint64("length", 0, "number of bytes of output for shake128/shake256 (default 32 or 64)")
+	rawOut := flag.Bool("raw", false, "write shake output as raw binary instead of hex")
+	outFileStr := flag.String("out", "", "file to write shake output to, defaults to stdout")
+	ioModeStr := flag.String("io", "read", "how to read the file to hash, read, or on linux direct (O_DIRECT) or mmap")
 	numIters := flag.Int("iter", 1, "number of iterations to run")
 	avgTimes := flag.Bool("avg", false, "whether to average the time results or not")
 
@@ -75,6 +312,45 @@ func main() {
 		log.Fatalf("error : invalid units specification %s\n", *unitStr)
 	}
 
+	// Check the algorithm to use - the shake algorithms stream their output
+	// instead of being timed, so handle them here
+	var newHasher func() hash.Hash
+	switch strings.ToLower(*algStr) {
+	case "sha3_224":
+		newHasher = sha3.New224
+	case "sha3_256":
+		newHasher = sha3.New256
+	case "sha3_384":
+		newHasher = sha3.New384
+	case "sha3_512":
+		newHasher = sha3.New512
+	case "shake128", "shake256":
+		var h sha3.ShakeHash
+		length := *outLength
+		if strings.ToLower(*algStr) == "shake128" {
+			h = sha3.NewShake128()
+			if length == 0 {
+				length = 32
+			}
+		} else {
+			h = sha3.NewShake256()
+			if length == 0 {
+				length = 64
+			}
+		}
+		if err := shakeOutput(h, *fileStr, *outFileStr, length, *rawOut); err != nil {
+			log.Fatalf("error : %v\n", err)
+		}
+		return
+	default:
+		log.Fatalf("error : invalid algorithm %s\n", *algStr)
+	}
+
+	// Check the I/O mode
+	if err := checkIOMode(*ioModeStr); err != nil {
+		log.Fatalf("error : %v\n", err)
+	}
+
 	// Check whether the file exists or not, if it doesn't that might be okay as we
 	// might be generating a random file
 	var fileExistsQ bool
@@ -93,50 +369,43 @@ func main() {
 		// File was specified but doesn't exist - we can use err as it won't have been cleared yet
 		log.Fatalf("error : file %s doesn't exist\n", *fileStr)
 	case !fileExistsQ:
-		// then don't use a file - generate one randomly
+		// then don't use a file - hash randomly generated data as it's
+		// generated, so that sizes larger than RAM can be used and the
+		// page cache doesn't affect the timings
 		fileSize = (*randomSizeMB) * 1048576
-		randomBytes := make([]byte, fileSize)
-
-		// Read this many bytes from the OS's random number generator
-		_, err := rand.Read(randomBytes)
-
-		// Make a new temp file
-		tmpfile, err := ioutil.TempFile("", "sha3sum_example")
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		// Clean up automatically
-		defer os.Remove(tmpfile.Name())
-
-		// Write out all the random bytes to the file
-		if _, err := tmpfile.Write(randomBytes); err != nil {
-			log.Fatal(err)
-		}
-
-		// Close the file as we want osutil.FileDigest to read the file
-		if err := tmpfile.Close(); err != nil {
-			log.Fatal(err)
-		}
-
-		// Can't take the address of .Name() method, so save it in a variable first
-		var tempfileName string
-		tempfileName = tmpfile.Name()
-		fileStr = &tempfileName
 	}
 
-	// Run a single run and print out human readable form
-	// First check what algorithm to use
-	// hasherToUse := NewKeccak512()
-
 	// Run the hash the specified number of iterations
 	timeResults := make([]time.Duration, *numIters)
+	splitResults := make([]digestTimes, *numIters)
 	var hashBytes []byte
 	var timeRes time.Duration
+	var splitRes digestTimes
+	var err error
 	for i := 0; i < *numIters; i += 1 {
-		hasherToUse := sha3.New512()
-		hashBytes, timeRes = timeFileHash(hasherToUse, *fileStr)
+		hasherToUse := newHasher()
+		if fileExistsQ {
+			hashBytes, timeRes, splitRes, err = timeFileHash(hasherToUse, *fileStr, *ioModeStr)
+		} else {
+			hashBytes, timeRes, splitRes, err = timeStreamHash(hasherToUse, randomSource(*seedStr), fileSize, *teeStr)
+		}
+		if err != nil {
+			log.Fatalf("error : %v\n", err)
+		}
 		timeResults[i] = timeRes
+		splitResults[i] = splitRes
+	}
+
+	// Describe where the data came from if it wasn't a file
+	if !fileExistsQ {
+		switch {
+		case *teeStr != "":
+			*fileStr = *teeStr
+		case *seedStr != "":
+			*fileStr = fmt.Sprintf("(shake256 stream of seed %q)", *seedStr)
+		default:
+			*fileStr = "(random stream)"
+		}
 	}
 
 	// Print the hash and the file name
@@ -144,17 +413,23 @@ func main() {
 
 	// Print the stats
 	if *avgTimes {
-		var timeAvg float64
-		for _, timeRes := range timeResults {
+		var timeAvg, ioAvg, hashAvg float64
+		for i, timeRes := range timeResults {
 			timeAvg += float64(timeRes)
+			ioAvg += float64(splitResults[i].io)
+			hashAvg += float64(splitResults[i].hash)
 		}
 		timeAvg = timeAvg / float64(*numIters)
+		ioAvg = ioAvg / float64(*numIters)
+		hashAvg = hashAvg / float64(*numIters)
 
 		fmt.Printf("Calculated in %3f sec, %5.2f MBps\n", timeAvg/float64(timeVal), float64(fileSize)/1048576/(timeAvg/float64(time.Second)))
+		fmt.Printf("  I/O %3f sec, hash %3f sec, %5.2f MBps hashing\n", ioAvg/float64(timeVal), hashAvg/float64(timeVal), float64(fileSize)/1048576/(hashAvg/float64(time.Second)))
 	} else {
 		// just print off the stats for each run
-		for _, timeRes := range timeResults {
+		for i, timeRes := range timeResults {
 			fmt.Printf("Calculated in %3f sec, %5.2f MBps\n", float64(timeRes)/float64(timeVal), float64(fileSize)/1048576/(float64(timeRes)/float64(time.Second)))
+			fmt.Printf("  I/O %3f sec, hash %3f sec, %5.2f MBps hashing\n", float64(splitResults[i].io)/float64(timeVal), float64(splitResults[i].hash)/float64(timeVal), float64(fileSize)/1048576/(float64(splitResults[i].hash)/float64(time.Second)))
 		}
 	}
 
@@ -81,16 +81,19 @@ func (h *Sha3FastHasher) Sum(b []byte) []byte {
 	// Allocate data for the output buffer - need to take the ceiling of the
 	// hashbitlen / 8 for the size of it
 	outputlen := (h.Hashbitlen + 7) / 8
-	// fmt.Printf("bytes addr: %#v\n", bytes)
+	// The digest can't go in lasthashedbytes, which is nil before the first
+	// Write and shorter than the digest for short messages
+	output := C.malloc(C.size_t(outputlen))
+	defer C.free(output)
 	res := C.Keccak_HashFinal(
 		&h.instance,
-		(*_Ctype_uchar)(h.lasthashedbytes),
+		(*_Ctype_uchar)(output),
 	)
 	if res != 0 {
 		return nil
 	}
 	// copy the c memory to go memory
-	return C.GoBytes(h.lasthashedbytes, _Ctype_int(outputlen))
+	return C.GoBytes(output, _Ctype_int(outputlen))
 }
 
 // Reset resets the hasher's internal state to its initial state.
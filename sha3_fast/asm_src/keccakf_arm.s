@@ -279,3 +279,146 @@ KeccakF1600:
     vpop    {q4-q7}
     bx      r2
 
+
+
+@ ----------------------------------------------------------------------------
+@
+@  void KeccakF1600Absorb( void *states, void *constants, const void *data, int blocks, int rate )
+@
+@  Xors blocks whole blocks of rate bytes from data into the state, applying
+@  the permutation after each one, and only loads and stores the state once.
+@  The data doesn't need to be aligned.
+@
+
+.macro    XorLane     dst
+    vld1.8      {d25}, [r3]!
+    veor.64     \dst, \dst, d25
+    .endm
+
+.align 8
+.global   KeccakF1600Absorb
+.type   KeccakF1600Absorb, %function;
+KeccakF1600Absorb:
+    @ sp+4 is taken as the start of the state array
+    @ sp+8 is taken as the start of the constants
+    @ sp+12 is taken as the start of the data
+    @ sp+16 is the number of blocks
+    @ sp+20 is the rate in bytes
+    ldr     r0, [sp, #4]
+    ldr     r1, [sp, #8]
+    ldr     r3, [sp, #12]
+    ldr     r12, [sp, #16]
+    ldr     r4, [sp, #20]
+    mov     r2, lr
+    vpush   {q4-q7}
+    @ load state
+    vld1.64 d0, [r0:64]!
+    vld1.64 d2, [r0:64]!
+    vld1.64 d4, [r0:64]!
+    vld1.64 d6, [r0:64]!
+    vld1.64 d8, [r0:64]!
+    vld1.64 d1, [r0:64]!
+    vld1.64 d3, [r0:64]!
+    vld1.64 d5, [r0:64]!
+    vld1.64 d7, [r0:64]!
+    vld1.64 d9, [r0:64]!
+    vld1.64 d10, [r0:64]!
+    vld1.64 d12, [r0:64]!
+    vld1.64 d14, [r0:64]!
+    vld1.64 d16, [r0:64]!
+    vld1.64 d18, [r0:64]!
+    vld1.64 d11, [r0:64]!
+    vld1.64 d13, [r0:64]!
+    vld1.64 d15, [r0:64]!
+    vld1.64 d17, [r0:64]!
+    vld1.64 d19, [r0:64]!
+    vld1.64 { d20, d21 }, [r0:128]!
+    vld1.64 { d22, d23 }, [r0:128]!
+    vld1.64 d24, [r0:64]
+    sub     r0, r0, #24*8
+KeccakF1600Absorb_loop:
+    @ xor in a block, d25 is free between permutations
+    XorLane d0
+    XorLane d2
+    XorLane d4
+    XorLane d6
+    XorLane d8
+    XorLane d1
+    XorLane d3
+    XorLane d5
+    XorLane d7
+    cmp     r4, #104
+    blt     KeccakF1600Absorb_permute
+    XorLane d9
+    XorLane d10
+    XorLane d12
+    XorLane d14
+    cmp     r4, #136
+    blt     KeccakF1600Absorb_permute
+    XorLane d16
+    XorLane d18
+    XorLane d11
+    XorLane d13
+    cmp     r4, #144
+    blt     KeccakF1600Absorb_permute
+    XorLane d15
+    cmp     r4, #168
+    blt     KeccakF1600Absorb_permute
+    XorLane d17
+    XorLane d19
+    XorLane d20
+KeccakF1600Absorb_permute:
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    KeccakRound
+    @ rewind the constants for the next block
+    sub     r1, r1, #24*8
+    subs    r12, r12, #1
+    bne     KeccakF1600Absorb_loop
+    @ store state
+    vst1.64 d0, [r0:64]!
+    vst1.64 d2, [r0:64]!
+    vst1.64 d4, [r0:64]!
+    vst1.64 d6, [r0:64]!
+    vst1.64 d8, [r0:64]!
+    vst1.64 d1, [r0:64]!
+    vst1.64 d3, [r0:64]!
+    vst1.64 d5, [r0:64]!
+    vst1.64 d7, [r0:64]!
+    vst1.64 d9, [r0:64]!
+    vst1.64 d10, [r0:64]!
+    vst1.64 d12, [r0:64]!
+    vst1.64 d14, [r0:64]!
+    vst1.64 d16, [r0:64]!
+    vst1.64 d18, [r0:64]!
+    vst1.64 d11, [r0:64]!
+    vst1.64 d13, [r0:64]!
+    vst1.64 d15, [r0:64]!
+    vst1.64 d17, [r0:64]!
+    vst1.64 d19, [r0:64]!
+    vst1.64 { d20, d21 }, [r0:128]!
+    vst1.64 { d22, d23 }, [r0:128]!
+    vst1.64 d24, [r0:64]
+    vpop    {q4-q7}
+    bx      r2
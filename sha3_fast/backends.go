@@ -0,0 +1,46 @@
+package sha3_fast
+
+import (
+	"fmt"
+	"sort"
+)
+
+// permutations holds the implementations of the KeccakF-1600 permutation
+// that are available on this platform keyed by name. The generic and
+// bit-interleaved implementations are always available, and the
+// architecture specific files add their own implementations in init
+// functions. All of them work on ordinary lanes.
+var permutations = map[string]func(a *[25]uint64){
+	"generic":     keccakF1600Generic,
+	"interleaved": onOrdinaryLanes(keccakF1600Interleaved),
+}
+
+// absorbBlocks, if set, xors the whole blocks of rate bytes in p into the
+// lanes a, applying the permutation after each one, in a single call that
+// keeps the state in registers between blocks. It is set by the architecture
+// specific files that have a fused implementation, and p must hold at least
+// one block.
+var absorbBlocks func(a *[25]uint64, p []byte, rate int)
+
+// PermutationBackends returns the sorted names of the KeccakF-1600
+// implementations that are available on this platform.
+func PermutationBackends() []string {
+	names := make([]string, 0, len(permutations))
+	for name := range permutations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// KeccakF1600With applies the full 24 round KeccakF-1600 permutation to the
+// lanes of a using the named implementation, which is useful for
+// cross-checking the implementations against each other.
+func KeccakF1600With(backend string, a *[25]uint64) error {
+	f, ok := permutations[backend]
+	if !ok {
+		return fmt.Errorf("sha3: unknown permutation backend %q", backend)
+	}
+	f(a)
+	return nil
+}
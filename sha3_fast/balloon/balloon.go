@@ -0,0 +1,331 @@
+// Package balloon implements Balloon hashing, the memory-hard password
+// hashing function of Boneh, Corrigan-Gibbs and Schechter
+// (https://eprint.iacr.org/2016/027), on SHA3-256 or SHAKE256, and its
+// parallel variant Balloon-M.
+//
+// The paper leaves the encoding of the integers and the conversion of blocks
+// to integers to the implementation. Here integers are encoded as 8
+// little-endian bytes, and a block is converted to an integer by reading its
+// first 8 bytes as a little-endian uint64.
+//
+// Passwords are stored as encoded strings, which hold the parameters and the
+// salt along with the hash:
+//
+//	$balloon$v=1$h=sha3-256,s=16384,t=3,d=3,p=1$<salt>$<hash>
+//
+// where the salt and the hash are base64 encoded without padding.
+package balloon
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+	"sync"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+)
+
+// Hash is the hash function Balloon is built on.
+type Hash int
+
+const (
+	// SHA3_256 uses SHA3-256, with 32 byte blocks.
+	SHA3_256 Hash = iota
+	// SHAKE256 uses SHAKE256 with 64 bytes of output, as 64 byte blocks.
+	SHAKE256
+)
+
+var hashNames = map[Hash]string{
+	SHA3_256: "sha3-256",
+	SHAKE256: "shake256",
+}
+
+// blockSize returns the size of the blocks of the buffer, which is also the
+// size of the output.
+func (h Hash) blockSize() int {
+	if h == SHAKE256 {
+		return 64
+	}
+	return 32
+}
+
+// Params are the parameters of Balloon hashing.
+type Params struct {
+	Hash        Hash
+	SpaceCost   int // the number of blocks in the buffer
+	TimeCost    int // the number of rounds of mixing the buffer
+	Delta       int // the number of pseudorandom blocks mixed into each block
+	Parallelism int // the number of instances run in parallel, 1 for plain Balloon
+}
+
+// DefaultParams use 512 KiB of memory, with the number of rounds and delta
+// recommended in the paper, which takes around a second on amd64.
+var DefaultParams = Params{Hash: SHA3_256, SpaceCost: 1 << 14, TimeCost: 3, Delta: 3, Parallelism: 1}
+
+// SaltSize is the size of the salts generated by GenerateFromPassword.
+const SaltSize = 16
+
+// The limits on the parameters, so that an encoded hash can't make
+// CompareHashAndPassword allocate more memory than is reasonable, or run for
+// more than a minute or two. MaxWork bounds the number of hashes,
+// TimeCost·SpaceCost·(1+2·Delta)·Parallelism, which is around 200 times
+// that of DefaultParams.
+const (
+	MaxSpaceCost   = 1 << 22 // 256 MiB of SHAKE256 blocks
+	MaxTimeCost    = 1 << 8
+	MaxDelta       = 1 << 4
+	MaxParallelism = 1 << 6
+	MaxMemory      = 1 << 28 // the memory used by all the instances together
+	MaxWork        = 1 << 26
+)
+
+// ErrMismatchedHashAndPassword is returned by CompareHashAndPassword when the
+// password doesn't match the hash.
+var ErrMismatchedHashAndPassword = errors.New("balloon: hashed password is not the hash of the given password")
+
+// ErrInvalidHash is returned by CompareHashAndPassword when the encoded hash
+// can't be parsed.
+var ErrInvalidHash = errors.New("balloon: invalid encoded hash")
+
+func (p *Params) validate() error {
+	if _, ok := hashNames[p.Hash]; !ok {
+		return fmt.Errorf("balloon: unknown hash %d", p.Hash)
+	}
+	if p.SpaceCost < 1 || p.TimeCost < 1 || p.Delta < 1 || p.Parallelism < 1 {
+		return errors.New("balloon: costs, delta and parallelism must be positive")
+	}
+	if p.SpaceCost > MaxSpaceCost || p.TimeCost > MaxTimeCost || p.Delta > MaxDelta || p.Parallelism > MaxParallelism {
+		return errors.New("balloon: costs, delta or parallelism too large")
+	}
+	if uint64(p.SpaceCost)*uint64(p.Hash.blockSize())*uint64(p.Parallelism) > MaxMemory {
+		return errors.New("balloon: parameters need too much memory")
+	}
+	if uint64(p.TimeCost)*uint64(p.SpaceCost)*uint64(1+2*p.Delta)*uint64(p.Parallelism) > MaxWork {
+		return errors.New("balloon: parameters need too much work")
+	}
+	return nil
+}
+
+// hasher computes the hashes of Balloon, with the counter shared by all the
+// hashes of an instance.
+type hasher struct {
+	h     hash.Hash
+	shake sha3.ShakeHash
+	cnt   uint64
+}
+
+func newHasher(h Hash) *hasher {
+	if h == SHAKE256 {
+		return &hasher{shake: sha3.NewShake256()}
+	}
+	return &hasher{h: sha3.New256()}
+}
+
+// begin starts a new hash with the next value of the counter.
+func (h *hasher) begin() {
+	var cnt [8]byte
+	binary.LittleEndian.PutUint64(cnt[:], h.cnt)
+	h.cnt++
+	h.write(cnt[:])
+}
+
+func (h *hasher) write(p []byte) {
+	if h.shake != nil {
+		h.shake.Write(p)
+	} else {
+		h.h.Write(p)
+	}
+}
+
+// finish writes the hash to dst, which is a block, and resets the hasher.
+func (h *hasher) finish(dst []byte) {
+	if h.shake != nil {
+		h.shake.Read(dst)
+		h.shake.Reset()
+	} else {
+		h.h.Sum(dst[:0])
+		h.h.Reset()
+	}
+}
+
+// balloon runs a single instance of Balloon hashing.
+func balloon(password, salt []byte, p *Params) []byte {
+	bs := p.Hash.blockSize()
+	buf := make([]byte, p.SpaceCost*bs)
+	block := func(i int) []byte { return buf[i*bs : (i+1)*bs] }
+	h := newHasher(p.Hash)
+
+	// Expand the input into the buffer.
+	h.begin()
+	h.write(password)
+	h.write(salt)
+	h.finish(block(0))
+	for m := 1; m < p.SpaceCost; m++ {
+		h.begin()
+		h.write(block(m - 1))
+		h.finish(block(m))
+	}
+
+	// Mix the buffer.
+	var idx [24]byte
+	other := make([]byte, bs)
+	for t := 0; t < p.TimeCost; t++ {
+		for m := 0; m < p.SpaceCost; m++ {
+			// Hash the previous and current blocks.
+			h.begin()
+			h.write(block((m + p.SpaceCost - 1) % p.SpaceCost))
+			h.write(block(m))
+			h.finish(block(m))
+
+			// Hash in pseudorandomly chosen blocks.
+			for i := 0; i < p.Delta; i++ {
+				binary.LittleEndian.PutUint64(idx[0:], uint64(t))
+				binary.LittleEndian.PutUint64(idx[8:], uint64(m))
+				binary.LittleEndian.PutUint64(idx[16:], uint64(i))
+				h.begin()
+				h.write(salt)
+				h.write(idx[:])
+				h.finish(other)
+				j := binary.LittleEndian.Uint64(other) % uint64(p.SpaceCost)
+
+				h.begin()
+				h.write(block(m))
+				h.write(block(int(j)))
+				h.finish(block(m))
+			}
+		}
+	}
+
+	// Extract the output from the buffer.
+	return append([]byte(nil), block(p.SpaceCost-1)...)
+}
+
+// Key derives a hash of the password with the given salt and parameters,
+// whose size is the block size of the hash function. With more than one
+// instance in parallel, the instances use the salt followed by their
+// number, from 1, as 8 little-endian bytes, and the output is the hash of
+// the password, the salt and the XOR of their outputs, as in Balloon-M. Key
+// returns an error if the parameters are invalid or exceed the limits.
+func Key(password, salt []byte, p Params) ([]byte, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if p.Parallelism == 1 {
+		return balloon(password, salt, &p), nil
+	}
+
+	outputs := make([][]byte, p.Parallelism)
+	var wg sync.WaitGroup
+	for i := range outputs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var n [8]byte
+			binary.LittleEndian.PutUint64(n[:], uint64(i+1))
+			outputs[i] = balloon(password, append(append([]byte(nil), salt...), n[:]...), &p)
+		}(i)
+	}
+	wg.Wait()
+
+	xor := outputs[0]
+	for _, out := range outputs[1:] {
+		for i := range xor {
+			xor[i] ^= out[i]
+		}
+	}
+	h := newHasher(p.Hash)
+	h.write(password)
+	h.write(salt)
+	h.write(xor)
+	out := make([]byte, p.Hash.blockSize())
+	h.finish(out)
+	return out, nil
+}
+
+// GenerateFromPassword hashes the password with a random salt and returns the
+// encoded hash, holding the parameters and the salt, to be stored and later
+// checked with CompareHashAndPassword.
+func GenerateFromPassword(password []byte, p Params) (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+	salt := make([]byte, SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := Key(password, salt, p)
+	if err != nil {
+		return "", err
+	}
+	return encode(salt, key, p), nil
+}
+
+func encode(salt, key []byte, p Params) string {
+	return fmt.Sprintf("$balloon$v=1$h=%s,s=%d,t=%d,d=%d,p=%d$%s$%s",
+		hashNames[p.Hash], p.SpaceCost, p.TimeCost, p.Delta, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+// Decode parses an encoded hash into its parameters, salt and key. It
+// returns ErrInvalidHash if the parameters exceed the limits.
+func Decode(encoded string) (p Params, salt, key []byte, err error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) != 6 || fields[0] != "" || fields[1] != "balloon" || fields[2] != "v=1" {
+		return p, nil, nil, ErrInvalidHash
+	}
+	params := strings.Split(fields[3], ",")
+	if len(params) != 5 {
+		return p, nil, nil, ErrInvalidHash
+	}
+	p.Hash = -1
+	for h, name := range hashNames {
+		if params[0] == "h="+name {
+			p.Hash = h
+		}
+	}
+	for i, v := range []*int{&p.SpaceCost, &p.TimeCost, &p.Delta, &p.Parallelism} {
+		name := "stdp"[i:i+1] + "="
+		if !strings.HasPrefix(params[i+1], name) {
+			return p, nil, nil, ErrInvalidHash
+		}
+		n, err := strconv.ParseUint(params[i+1][len(name):], 10, 31)
+		if err != nil {
+			return p, nil, nil, ErrInvalidHash
+		}
+		*v = int(n)
+	}
+	if p.validate() != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(fields[4]); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(fields[5]); err != nil || len(key) != p.Hash.blockSize() {
+		return p, nil, nil, ErrInvalidHash
+	}
+	return p, salt, key, nil
+}
+
+// CompareHashAndPassword checks whether the password matches the encoded
+// hash, in constant time with regard to the hash, returning
+// ErrMismatchedHashAndPassword if it doesn't.
+func CompareHashAndPassword(encoded string, password []byte) error {
+	p, salt, key, err := Decode(encoded)
+	if err != nil {
+		return err
+	}
+	derived, err := Key(password, salt, p)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(key, derived) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package balloon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+)
+
+func mustKey(t *testing.T, password, salt []byte, p Params) []byte {
+	key, err := Key(password, salt, p)
+	if err != nil {
+		t.Fatalf("%+v: %v", p, err)
+	}
+	return key
+}
+
+// referenceHash is the hash of the paper's pseudocode, on the concatenation
+// of the counter and the parts.
+func referenceHash(h Hash, cnt *uint64, parts ...[]byte) []byte {
+	in := make([]byte, 8)
+	binary.LittleEndian.PutUint64(in, *cnt)
+	*cnt++
+	for _, part := range parts {
+		in = append(in, part...)
+	}
+	if h == SHAKE256 {
+		out := make([]byte, 64)
+		sha3.ShakeSum256(out, in)
+		return out
+	}
+	out := sha3.Sum256(in)
+	return out[:]
+}
+
+// referenceBalloon is a direct transcription of the pseudocode of the paper.
+func referenceBalloon(password, salt []byte, p Params) []byte {
+	var cnt uint64
+	buf := make([][]byte, p.SpaceCost)
+	buf[0] = referenceHash(p.Hash, &cnt, password, salt)
+	for m := 1; m < p.SpaceCost; m++ {
+		buf[m] = referenceHash(p.Hash, &cnt, buf[m-1])
+	}
+	for t := 0; t < p.TimeCost; t++ {
+		for m := 0; m < p.SpaceCost; m++ {
+			prev := buf[(m+p.SpaceCost-1)%p.SpaceCost]
+			buf[m] = referenceHash(p.Hash, &cnt, prev, buf[m])
+			for i := 0; i < p.Delta; i++ {
+				idx := make([]byte, 24)
+				binary.LittleEndian.PutUint64(idx[0:], uint64(t))
+				binary.LittleEndian.PutUint64(idx[8:], uint64(m))
+				binary.LittleEndian.PutUint64(idx[16:], uint64(i))
+				other := binary.LittleEndian.Uint64(referenceHash(p.Hash, &cnt, salt, idx)) % uint64(p.SpaceCost)
+				buf[m] = referenceHash(p.Hash, &cnt, buf[m], buf[other])
+			}
+		}
+	}
+	return buf[p.SpaceCost-1]
+}
+
+func TestKeyReference(t *testing.T) {
+	password, salt := []byte("password"), []byte("salt")
+	for _, p := range []Params{
+		{SHA3_256, 1, 1, 1, 1},
+		{SHA3_256, 16, 3, 3, 1},
+		{SHA3_256, 33, 1, 5, 1},
+		{SHAKE256, 16, 2, 3, 1},
+	} {
+		got, want := mustKey(t, password, salt, p), referenceBalloon(password, salt, p)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%+v: got %x, want %x", p, got, want)
+		}
+	}
+}
+
+func TestKeyParallel(t *testing.T) {
+	password, salt := []byte("password"), []byte("salt")
+	p := Params{SHA3_256, 16, 2, 3, 3}
+
+	var xor [32]byte
+	for i := 1; i <= p.Parallelism; i++ {
+		var n [8]byte
+		binary.LittleEndian.PutUint64(n[:], uint64(i))
+		single := p
+		single.Parallelism = 1
+		for j, b := range referenceBalloon(password, append(append([]byte(nil), salt...), n[:]...), single) {
+			xor[j] ^= b
+		}
+	}
+	want := sha3.Sum256(append(append(append([]byte(nil), password...), salt...), xor[:]...))
+	if got := mustKey(t, password, salt, p); !bytes.Equal(got, want[:]) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+// TestKeyParams checks that every parameter changes the output.
+func TestKeyParams(t *testing.T) {
+	base := Params{SHA3_256, 8, 1, 3, 1}
+	outputs := map[string]string{string(mustKey(t, []byte("pw"), []byte("salt"), base)): "base"}
+	for name, p := range map[string]Params{
+		"hash":        {SHAKE256, 8, 1, 3, 1},
+		"space":       {SHA3_256, 9, 1, 3, 1},
+		"time":        {SHA3_256, 8, 2, 3, 1},
+		"delta":       {SHA3_256, 8, 1, 4, 1},
+		"parallelism": {SHA3_256, 8, 1, 3, 2},
+	} {
+		out := string(mustKey(t, []byte("pw"), []byte("salt"), p))
+		if other, ok := outputs[out]; ok {
+			t.Errorf("%s gives the same output as %s", name, other)
+		}
+		outputs[out] = name
+	}
+}
+
+func TestCompareHashAndPassword(t *testing.T) {
+	p := Params{SHAKE256, 64, 2, 3, 2}
+	encoded, err := GenerateFromPassword([]byte("hunter2"), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, "$balloon$v=1$h=shake256,s=64,t=2,d=3,p=2$") {
+		t.Errorf("unexpected encoding %s", encoded)
+	}
+	if err := CompareHashAndPassword(encoded, []byte("hunter2")); err != nil {
+		t.Errorf("correct password: %v", err)
+	}
+	if err := CompareHashAndPassword(encoded, []byte("hunter3")); err != ErrMismatchedHashAndPassword {
+		t.Errorf("wrong password gave %v", err)
+	}
+
+	decoded, salt, key, err := Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != p || len(salt) != SaltSize || !bytes.Equal(key, mustKey(t, []byte("hunter2"), salt, p)) {
+		t.Errorf("Decode gave %+v, %x, %x", decoded, salt, key)
+	}
+
+	other, _ := GenerateFromPassword([]byte("hunter2"), p)
+	if other == encoded {
+		t.Errorf("the same salt was generated twice")
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	valid := encode([]byte("salt"), make([]byte, 32), Params{SHA3_256, 4, 1, 3, 1})
+	if _, _, _, err := Decode(valid); err != nil {
+		t.Fatalf("%s: %v", valid, err)
+	}
+	for _, encoded := range []string{
+		"",
+		"$balloon$v=1$h=sha3-256,s=4,t=1,d=3,p=1$c2FsdA",
+		"$argon2id$v=1$h=sha3-256,s=4,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=2$h=sha3-256,s=4,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha2-256,s=4,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,t=4,s=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=0,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=-4,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=4,t=1,d=3$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=4,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 42),
+		"$balloon$v=1$h=shake256,s=4,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=4,t=1,d=3,p=1$c2F!dA$" + strings.Repeat("A", 43),
+		// Costs and parallelism over the limits, which must be rejected
+		// before allocating anything.
+		"$balloon$v=1$h=sha3-256,s=2147483647,t=1,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=4,t=2147483647,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=4,t=1,d=2147483647,p=1$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=4,t=1,d=3,p=2147483647$c2FsdA$" + strings.Repeat("A", 43),
+		"$balloon$v=1$h=sha3-256,s=4194304,t=1,d=3,p=64$c2FsdA$" + strings.Repeat("A", 43),
+		// Within the limits one by one and in memory, but just over MaxWork.
+		"$balloon$v=1$h=sha3-256,s=1048576,t=10,d=3,p=1$c2FsdA$" + strings.Repeat("A", 43),
+	} {
+		if _, _, _, err := Decode(encoded); err != ErrInvalidHash {
+			t.Errorf("%q: got %v", encoded, err)
+		}
+		if err := CompareHashAndPassword(encoded, nil); err != ErrInvalidHash {
+			t.Errorf("%q: CompareHashAndPassword gave %v", encoded, err)
+		}
+	}
+}
+
+func TestKeyLimits(t *testing.T) {
+	for _, p := range []Params{
+		{SHA3_256, 0, 1, 1, 1},
+		{SHA3_256, MaxSpaceCost + 1, 1, 1, 1},
+		{SHA3_256, 1, MaxTimeCost + 1, 1, 1},
+		{SHA3_256, 1, 1, MaxDelta + 1, 1},
+		{SHA3_256, 1, 1, 1, MaxParallelism + 1},
+		// Within the limits one by one, but not together.
+		{SHAKE256, MaxSpaceCost, 1, 1, 2},
+		{SHA3_256, MaxMemory / 32 / 4, 1, 1, 5},
+		{SHA3_256, 1 << 20, 10, 3, 1},
+		{SHA3_256, MaxSpaceCost, MaxTimeCost, MaxDelta, 1},
+		{Hash(7), 1, 1, 1, 1},
+	} {
+		if _, err := Key([]byte("pw"), []byte("salt"), p); err == nil {
+			t.Errorf("%+v: Key succeeded", p)
+		}
+		if _, err := GenerateFromPassword([]byte("pw"), p); err == nil {
+			t.Errorf("%+v: GenerateFromPassword succeeded", p)
+		}
+	}
+
+	// The largest parameters just under MaxWork are accepted, as are the
+	// defaults.
+	for _, p := range []Params{
+		{SHA3_256, 1 << 20, 9, 3, 1},
+		{SHAKE256, 1 << 16, 1, 15, 32},
+		DefaultParams,
+	} {
+		if err := p.validate(); err != nil {
+			t.Errorf("%+v: %v", p, err)
+		}
+	}
+}
+
+func BenchmarkDefaultParams(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Key([]byte("password"), []byte("salt"), DefaultParams)
+	}
+}
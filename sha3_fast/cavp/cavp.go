@@ -0,0 +1,358 @@
+// Package cavp parses the response (.rsp) files of the NIST Cryptographic
+// Algorithm Validation Program for SHA-3 and SHAKE, and checks hash
+// implementations against them.
+//
+// The SHA-3 files (SHA3_224ShortMsg.rsp, SHA3_224LongMsg.rsp,
+// SHA3_224Monte.rsp, ...) are checked with CheckHash against any hash.Hash,
+// and the SHAKE files (SHAKE128ShortMsg.rsp, SHAKE128LongMsg.rsp,
+// SHAKE128Monte.rsp, SHAKE128VariableOut.rsp, ...) with CheckXOF against any
+// extendable output function. A new instance of the hash is created for
+// every vector, so implementations that can't be Reset can still be tested.
+//
+// Only the byte-oriented vectors are supported, records with message
+// lengths that aren't a multiple of 8 bits are reported as errors.
+package cavp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Record is a single group of "Name = value" lines from a response file,
+// along with the bracketed parameters that were in effect for it.
+type Record struct {
+	// Line is the line number the record starts on
+	Line int
+	// Params holds the parameters from "[Name = value]" lines, which apply
+	// to all following records until they are changed
+	Params map[string]string
+	// Fields holds the "Name = value" lines of the record
+	Fields map[string]string
+}
+
+// Has returns whether the record has all of the named fields.
+func (r *Record) Has(names ...string) bool {
+	for _, name := range names {
+		if _, ok := r.Fields[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Int returns the named field, or parameter if there is no such field, as
+// an integer.
+func (r *Record) Int(name string) (int, error) {
+	val, ok := r.Fields[name]
+	if !ok {
+		if val, ok = r.Params[name]; !ok {
+			return 0, fmt.Errorf("line %d: missing %s", r.Line, name)
+		}
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("line %d: invalid %s: %v", r.Line, name, err)
+	}
+	return n, nil
+}
+
+// Bytes returns the named field decoded from hex.
+func (r *Record) Bytes(name string) ([]byte, error) {
+	val, ok := r.Fields[name]
+	if !ok {
+		return nil, fmt.Errorf("line %d: missing %s", r.Line, name)
+	}
+	b, err := hex.DecodeString(val)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: invalid %s: %v", r.Line, name, err)
+	}
+	return b, nil
+}
+
+// message returns the Msg field truncated to the length given by the Len
+// field if there is one, as CAVP encodes the empty message as "00".
+func (r *Record) message() ([]byte, error) {
+	msg, err := r.Bytes("Msg")
+	if err != nil {
+		return nil, err
+	}
+	if !r.Has("Len") {
+		return msg, nil
+	}
+	bits, err := r.Int("Len")
+	if err != nil {
+		return nil, err
+	}
+	if bits%8 != 0 {
+		return nil, fmt.Errorf("line %d: bit-oriented message of %d bits is not supported", r.Line, bits)
+	}
+	if bits/8 > len(msg) {
+		return nil, fmt.Errorf("line %d: Len %d is longer than Msg", r.Line, bits)
+	}
+	return msg[:bits/8], nil
+}
+
+// Parse reads all of the records from a response file.
+func Parse(r io.Reader) ([]Record, error) {
+	var records []Record
+	params := map[string]string{}
+	var cur *Record
+
+	scanner := bufio.NewScanner(r)
+	// LongMsg files have lines far longer than the default limit
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "":
+			cur = nil
+		case strings.HasPrefix(text, "#"):
+			// comment
+		case strings.HasPrefix(text, "["):
+			if !strings.HasSuffix(text, "]") {
+				return nil, fmt.Errorf("line %d: unterminated parameter", line)
+			}
+			// Copy the parameters so that earlier records keep theirs
+			newParams := make(map[string]string, len(params)+1)
+			for k, v := range params {
+				newParams[k] = v
+			}
+			name, val := splitAssignment(text[1 : len(text)-1])
+			newParams[name] = val
+			params = newParams
+			cur = nil
+		default:
+			if !strings.Contains(text, "=") {
+				return nil, fmt.Errorf("line %d: expected Name = value", line)
+			}
+			if cur == nil {
+				records = append(records, Record{Line: line, Params: params, Fields: map[string]string{}})
+				cur = &records[len(records)-1]
+			}
+			name, val := splitAssignment(text)
+			cur.Fields[name] = val
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// ParseFile reads all of the records from the named response file.
+func ParseFile(filename string) ([]Record, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	records, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return records, nil
+}
+
+// Algorithm returns the name of the algorithm a response file is for, such
+// as "SHA3-256" or "SHAKE128", based on the CAVP file naming, or "" if it
+// isn't recognised.
+func Algorithm(filename string) string {
+	base := filepath.Base(filename)
+	for _, alg := range []string{"SHA3_224", "SHA3_256", "SHA3_384", "SHA3_512", "SHAKE128", "SHAKE256"} {
+		if strings.HasPrefix(base, alg) {
+			return strings.Replace(alg, "_", "-", 1)
+		}
+	}
+	return ""
+}
+
+func splitAssignment(s string) (string, string) {
+	i := strings.Index(s, "=")
+	if i < 0 {
+		return strings.TrimSpace(s), ""
+	}
+	return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:])
+}
+
+// Monte Carlo tests run this many iterations between each checkpoint.
+const monteIterations = 1000
+
+// CheckHash checks the SHA-3 ShortMsg, LongMsg and Monte records against
+// hashes created by newHash. It returns the number of vectors checked and an
+// error describing the first mismatch, if any.
+func CheckHash(records []Record, newHash func() hash.Hash) (int, error) {
+	var checked int
+	var md []byte
+	for i := range records {
+		r := &records[i]
+		switch {
+		case r.Has("Msg", "MD"):
+			msg, err := r.message()
+			if err != nil {
+				return checked, err
+			}
+			want, err := r.Bytes("MD")
+			if err != nil {
+				return checked, err
+			}
+			h := newHash()
+			h.Write(msg)
+			if got := h.Sum(nil); !bytes.Equal(got, want) {
+				return checked, fmt.Errorf("line %d: got MD %x, want %x", r.Line, got, want)
+			}
+			checked++
+		case r.Has("Seed"):
+			seed, err := r.Bytes("Seed")
+			if err != nil {
+				return checked, err
+			}
+			md = seed
+		case r.Has("COUNT", "MD"):
+			// Each checkpoint hashes the previous digest 1000 times
+			if md == nil {
+				return checked, fmt.Errorf("line %d: Monte Carlo record without a Seed", r.Line)
+			}
+			want, err := r.Bytes("MD")
+			if err != nil {
+				return checked, err
+			}
+			for j := 0; j < monteIterations; j++ {
+				h := newHash()
+				h.Write(md)
+				md = h.Sum(md[:0])
+			}
+			if !bytes.Equal(md, want) {
+				return checked, fmt.Errorf("line %d: got Monte Carlo MD %x, want %x", r.Line, md, want)
+			}
+			checked++
+		default:
+			return checked, fmt.Errorf("line %d: unrecognised SHA-3 record", r.Line)
+		}
+	}
+	return checked, nil
+}
+
+// XOF is the interface to extendable output functions such as SHAKE, which
+// is satisfied by sha3_fast.ShakeHash and its equivalents in other packages.
+type XOF interface {
+	io.Writer
+	io.Reader
+}
+
+// The SHAKE Monte Carlo parameters, in bits.
+const (
+	minOutputLenParam = "Minimum Output Length (bits)"
+	maxOutputLenParam = "Maximum Output Length (bits)"
+	// monteMsgLen is the length of the message hashed in each iteration of
+	// the SHAKE Monte Carlo test
+	monteMsgLen = 16
+)
+
+// CheckXOF checks the SHAKE ShortMsg, LongMsg, VariableOut and Monte records
+// against XOFs created by newXOF. It returns the number of vectors checked
+// and an error describing the first mismatch, if any.
+func CheckXOF(records []Record, newXOF func() XOF) (int, error) {
+	var checked int
+	var output []byte
+	var outputLen int
+	for i := range records {
+		r := &records[i]
+		switch {
+		case r.Has("Msg", "Output"):
+			// ShortMsg and LongMsg give the output length as a parameter,
+			// VariableOut gives it in each record
+			msg, err := r.message()
+			if err != nil {
+				return checked, err
+			}
+			want, err := r.Bytes("Output")
+			if err != nil {
+				return checked, err
+			}
+			bits, err := r.Int("Outputlen")
+			if err != nil {
+				return checked, err
+			}
+			if bits != 8*len(want) {
+				return checked, fmt.Errorf("line %d: Outputlen %d doesn't match Output", r.Line, bits)
+			}
+			h := newXOF()
+			h.Write(msg)
+			got := make([]byte, len(want))
+			h.Read(got)
+			if !bytes.Equal(got, want) {
+				return checked, fmt.Errorf("line %d: got Output %x, want %x", r.Line, got, want)
+			}
+			checked++
+		case r.Has("Msg"):
+			// The initial message of the Monte Carlo test, output starts
+			// at the maximum length
+			msg, err := r.Bytes("Msg")
+			if err != nil {
+				return checked, err
+			}
+			maxBits, err := r.Int(maxOutputLenParam)
+			if err != nil {
+				return checked, err
+			}
+			output, outputLen = msg, maxBits/8
+		case r.Has("COUNT", "Output"):
+			if output == nil {
+				return checked, fmt.Errorf("line %d: Monte Carlo record without a Msg", r.Line)
+			}
+			minBits, err := r.Int(minOutputLenParam)
+			if err != nil {
+				return checked, err
+			}
+			maxBits, err := r.Int(maxOutputLenParam)
+			if err != nil {
+				return checked, err
+			}
+			want, err := r.Bytes("Output")
+			if err != nil {
+				return checked, err
+			}
+			output, outputLen = shakeMonte(newXOF, output, outputLen, minBits/8, maxBits/8)
+			if !bytes.Equal(output, want) {
+				return checked, fmt.Errorf("line %d: got Monte Carlo Output %x, want %x", r.Line, output, want)
+			}
+			checked++
+		default:
+			return checked, fmt.Errorf("line %d: unrecognised SHAKE record", r.Line)
+		}
+	}
+	return checked, nil
+}
+
+// shakeMonte runs one checkpoint of the SHAKE Monte Carlo test from SHA3VS.
+// Each iteration hashes the leftmost 128 bits of the previous output, and the
+// rightmost 16 bits of the new output choose the length of the next one. It
+// returns the final output and the length of the next output in bytes.
+func shakeMonte(newXOF func() XOF, output []byte, outputLen, minLen, maxLen int) ([]byte, int) {
+	var msg [monteMsgLen]byte
+	for j := 0; j < monteIterations; j++ {
+		// The message is zero padded if the output was shorter than 128 bits
+		for k := range msg {
+			msg[k] = 0
+		}
+		copy(msg[:], output)
+
+		h := newXOF()
+		h.Write(msg[:])
+		output = make([]byte, outputLen)
+		h.Read(output)
+
+		rightmost := int(binary.BigEndian.Uint16(output[len(output)-2:]))
+		outputLen = minLen + rightmost%(maxLen-minLen+1)
+	}
+	return output, outputLen
+}
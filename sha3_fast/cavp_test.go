@@ -0,0 +1,86 @@
+package sha3_fast
+
+// Tests against NIST CAVP response files. testdata/cavp/generated holds files
+// in the CAVP layout generated with Python's hashlib, which test the parser
+// and the Monte Carlo and VariableOut harness but prove nothing beyond
+// agreement with hashlib. The official byte-oriented response files from the
+// CAVP SHA-3 and SHAKE zips go in testdata/cavp itself, and TestCAVP fails
+// until all of them are there.
+
+import (
+	"hash"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anonymouse64/sha3_arm/sha3_fast/cavp"
+)
+
+var cavpHashes = map[string]func() hash.Hash{
+	"SHA3-224": New224,
+	"SHA3-256": New256,
+	"SHA3-384": New384,
+	"SHA3-512": New512,
+}
+
+// cavpFiles are the official response files expected in testdata/cavp.
+var cavpFiles = []string{
+	"SHA3_224ShortMsg.rsp", "SHA3_224LongMsg.rsp", "SHA3_224Monte.rsp",
+	"SHA3_256ShortMsg.rsp", "SHA3_256LongMsg.rsp", "SHA3_256Monte.rsp",
+	"SHA3_384ShortMsg.rsp", "SHA3_384LongMsg.rsp", "SHA3_384Monte.rsp",
+	"SHA3_512ShortMsg.rsp", "SHA3_512LongMsg.rsp", "SHA3_512Monte.rsp",
+	"SHAKE128ShortMsg.rsp", "SHAKE128LongMsg.rsp", "SHAKE128Monte.rsp", "SHAKE128VariableOut.rsp",
+	"SHAKE256ShortMsg.rsp", "SHAKE256LongMsg.rsp", "SHAKE256Monte.rsp", "SHAKE256VariableOut.rsp",
+}
+
+// TestCAVP runs the official response files in testdata/cavp.
+func TestCAVP(t *testing.T) {
+	var files []string
+	for _, name := range cavpFiles {
+		file := filepath.Join("testdata", "cavp", name)
+		if _, err := os.Stat(file); err != nil {
+			t.Errorf("official CAVP response file missing: %v", err)
+			continue
+		}
+		files = append(files, file)
+	}
+	testCAVPFiles(t, files)
+}
+
+// TestCAVPGenerated runs the generated response files in
+// testdata/cavp/generated.
+func TestCAVPGenerated(t *testing.T) {
+	files, err := filepath.Glob("testdata/cavp/generated/*.rsp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no generated CAVP response files found")
+	}
+	testCAVPFiles(t, files)
+}
+
+func testCAVPFiles(t *testing.T, files []string) {
+	testUnalignedAndGeneric(t, func(impl string) {
+		for _, file := range files {
+			records, err := cavp.ParseFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			alg := cavp.Algorithm(file)
+			var n int
+			if newHash, ok := cavpHashes[alg]; ok {
+				n, err = cavp.CheckHash(records, newHash)
+			} else if newShake, ok := testShakes[alg]; ok {
+				n, err = cavp.CheckXOF(records, func() cavp.XOF { return newShake() })
+			} else {
+				t.Fatalf("%s: unknown algorithm", file)
+			}
+			if err != nil {
+				t.Errorf("%s (%s): %v", file, impl, err)
+			} else if n == 0 {
+				t.Errorf("%s: no vectors found", file)
+			}
+		}
+	})
+}
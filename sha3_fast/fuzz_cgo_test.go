@@ -0,0 +1,22 @@
+// +build cgo,libkeccak
+
+package sha3_fast
+
+// Built with -tags libkeccak when the cgo wrapper has been configured by
+// CMake, so that the fuzzer also compares against libkeccak.
+
+import (
+	sha3 "github.com/anonymouse64/sha3_arm/sha3"
+)
+
+func init() {
+	fuzzCgoSHA3512 = func(chunks [][]byte) []byte {
+		h := sha3.NewKeccak512()
+		for _, chunk := range chunks {
+			if len(chunk) > 0 {
+				h.Write(chunk)
+			}
+		}
+		return h.Sum(nil)
+	}
+}
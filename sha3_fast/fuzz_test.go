@@ -0,0 +1,176 @@
+package sha3_fast
+
+// Differential fuzzing of sha3_fast against golang.org/x/crypto/sha3, with
+// every combination of xorIn/copyOut and permutation implementation.
+
+import (
+	"bytes"
+	"hash"
+	"testing"
+
+	xsha3 "golang.org/x/crypto/sha3"
+)
+
+// fuzzAlgs lists the algorithms that are fuzzed, with the sha3_fast and
+// reference constructors for each. The reference is only ever used to hash a
+// whole message in one go, so it is trusted to get that right.
+var fuzzAlgs = []struct {
+	name    string
+	newFast func() *state
+	newRef  func() hash.Hash
+	newXOF  func() xsha3.ShakeHash
+}{
+	{name: "SHA3-224", newFast: func() *state { return &New224().(*Hash).d }, newRef: xsha3.New224},
+	{name: "SHA3-256", newFast: func() *state { return &New256().(*Hash).d }, newRef: xsha3.New256},
+	{name: "SHA3-384", newFast: func() *state { return &New384().(*Hash).d }, newRef: xsha3.New384},
+	{name: "SHA3-512", newFast: func() *state { return &New512().(*Hash).d }, newRef: xsha3.New512},
+	{name: "SHAKE128", newFast: func() *state { return NewShake128().(*state) }, newXOF: xsha3.NewShake128},
+	{name: "SHAKE256", newFast: func() *state { return NewShake256().(*state) }, newXOF: xsha3.NewShake256},
+}
+
+// fuzzCgoSHA3512 hashes the chunks in order with the cgo wrapper around
+// libkeccak, it is only set when the tests are built with it.
+var fuzzCgoSHA3512 func(chunks [][]byte) []byte
+
+// testPermutationBackends runs testf with every available implementation of
+// the permutation, all of which work on ordinary lanes. absorbBlocks is
+// cleared so that whole blocks go through the backend under test too, and
+// the default configuration gets a run of its own when it has a fused
+// implementation.
+func testPermutationBackends(t testing.TB, testf func(backend string)) {
+	xorInOrig, copyOutOrig, keccakF1600Orig := xorIn, copyOut, keccakF1600
+	interleavedOrig, absorbBlocksOrig := interleavedLanes, absorbBlocks
+	xorIn, copyOut, interleavedLanes = xorInGeneric, copyOutGeneric, false
+	absorbBlocks = nil
+	for _, name := range PermutationBackends() {
+		keccakF1600 = permutations[name]
+		testf(name)
+	}
+	xorIn, copyOut, keccakF1600 = xorInOrig, copyOutOrig, keccakF1600Orig
+	interleavedLanes, absorbBlocks = interleavedOrig, absorbBlocksOrig
+	if absorbBlocks != nil {
+		testf("fused")
+	}
+}
+
+// fuzzOps interprets ops as a script of operations on the hash for the given
+// algorithm, checking every output against the reference. Each op byte
+// selects an operation with its low 2 bits and uses the next byte, if there
+// is one, as an argument :
+//
+//	0 - write the next arg bytes of msg
+//	1 - Sum, or Read arg+1 bytes for SHAKE
+//	2 - Clone the hash, carrying on with the clone
+//	3 - Reset, starting again from the start of msg
+//
+// Any of msg that hasn't been written by the end is written before a final
+// Sum or Read.
+func fuzzOps(t *testing.T, impl string, algIdx int, msg, ops []byte) {
+	alg := fuzzAlgs[algIdx]
+	d := alg.newFast()
+
+	// The reference state is just the history of what has been written and
+	// how much has been read since the last reset
+	var written, chunks [][]byte
+	var pos, read int
+	squeezing := false
+
+	history := func() []byte { return bytes.Join(written, nil) }
+	check := func(n int) {
+		var got, want []byte
+		if alg.newXOF == nil {
+			got = d.Sum(nil)
+			ref := alg.newRef()
+			ref.Write(history())
+			want = ref.Sum(nil)
+		} else {
+			got = make([]byte, n)
+			d.Read(got)
+			ref := alg.newXOF()
+			ref.Write(history())
+			want = make([]byte, read+n)
+			ref.Read(want)
+			want = want[read:]
+			read += n
+			squeezing = true
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("%s (%s): after writing %d bytes and reading %d, got %x, want %x",
+				alg.name, impl, len(history()), read, got, want)
+		}
+	}
+
+	for i := 0; i < len(ops); i++ {
+		op := ops[i]
+		arg := 0
+		if i+1 < len(ops) {
+			arg = int(ops[i+1])
+			i++
+		}
+		switch op & 3 {
+		case 0:
+			if squeezing {
+				continue
+			}
+			if arg > len(msg)-pos {
+				arg = len(msg) - pos
+			}
+			chunk := msg[pos : pos+arg]
+			d.Write(chunk)
+			written = append(written, chunk)
+			chunks = append(chunks, chunk)
+			pos += arg
+		case 1:
+			check(arg + 1)
+		case 2:
+			d = d.clone()
+		case 3:
+			d.Reset()
+			written, chunks, pos, read, squeezing = nil, nil, 0, 0, false
+		}
+	}
+
+	if !squeezing {
+		d.Write(msg[pos:])
+		written = append(written, msg[pos:])
+		chunks = append(chunks, msg[pos:])
+	}
+	check(64)
+
+	// The cgo wrapper can only be given the chunks and summed once
+	if fuzzCgoSHA3512 != nil && alg.name == "SHA3-512" {
+		ref := alg.newRef()
+		ref.Write(history())
+		want := ref.Sum(nil)
+		if got := fuzzCgoSHA3512(chunks); !bytes.Equal(got, want) {
+			t.Fatalf("SHA3-512 (cgo): got %x, want %x", got, want)
+		}
+	}
+}
+
+// FuzzDifferential runs a script of writes, sums, reads, clones and resets
+// against every algorithm with every implementation, comparing all of the
+// outputs against golang.org/x/crypto/sha3.
+func FuzzDifferential(f *testing.F) {
+	// Seed with messages around one and two blocks of every rate, written in
+	// one go and split either side of the block boundary.
+	for _, rate := range []int{72, 104, 136, 144, 168} {
+		for _, n := range []int{rate - 1, rate, rate + 1, 2*rate - 1, 2 * rate, 2*rate + 1} {
+			msg := sequentialBytes(n)
+			f.Add(msg, []byte{})
+			f.Add(msg, []byte{0, byte(rate - 1), 0, 1, 1, 0})
+			f.Add(msg, []byte{0, byte(rate), 2, 0, 1, byte(rate - 1), 1, 200})
+			f.Add(msg, []byte{0, 1, 1, 0, 3, 0, 0, byte(rate + 1), 1, byte(rate)})
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, msg, ops []byte) {
+		testPermutationBackends(t, func(backend string) {
+			testUnalignedAndGeneric(t, func(impl string) {
+				for i := range fuzzAlgs {
+					fuzzOps(t, impl+"/"+backend, i, msg, ops)
+				}
+			})
+		})
+	})
+}
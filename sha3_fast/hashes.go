@@ -9,57 +9,195 @@ package sha3_fast
 // bytes.
 
 import (
+	"crypto"
 	"hash"
 )
 
+// Hash is a SHA-3 hash, as returned by New224, New256, New384 and New512
+// behind the hash.Hash interface. Its state can also be cloned, for instance
+// to hash several messages with a common prefix once, and marshaled, in the
+// format of crypto/sha3 in the standard library. The methods beyond
+// hash.Hash are reached with a type assertion:
+//
+//	h := sha3.New256().(*sha3.Hash)
+//	c := h.Clone()
+//
+// The zero value is a usable SHA3-256 hash, as in crypto/sha3. A Hash must
+// not be copied, as its state points into itself, so use Clone instead.
+type Hash struct {
+	_ noCopy
+	d state
+}
+
+// noCopy makes the copylocks check of go vet report copies of the struct
+// that embeds it.
+type noCopy struct{}
+
+func (*noCopy) Lock()   {}
+func (*noCopy) Unlock() {}
+
+func newHash(rate, outputLen int) *Hash {
+	h := new(Hash)
+	h.d.init(rate, outputLen, 0x06)
+	return h
+}
+
+// init makes the zero value a SHA3-256 hash.
+func (h *Hash) init() {
+	if h.d.a == nil {
+		h.d.init(136, 32, 0x06)
+	}
+}
+
 // New224 creates a new SHA3-224 hash.
 // Its generic security strength is 224 bits against preimage attacks,
 // and 112 bits against collision attacks.
-func New224() hash.Hash { return &state{rate: 144, outputLen: 28, dsbyte: 0x06} }
+// The returned hash is a *Hash.
+func New224() hash.Hash { return newHash(144, 28) }
 
 // New256 creates a new SHA3-256 hash.
 // Its generic security strength is 256 bits against preimage attacks,
 // and 128 bits against collision attacks.
-func New256() hash.Hash { return &state{rate: 136, outputLen: 32, dsbyte: 0x06} }
+// The returned hash is a *Hash.
+func New256() hash.Hash { return newHash(136, 32) }
 
 // New384 creates a new SHA3-384 hash.
 // Its generic security strength is 384 bits against preimage attacks,
 // and 192 bits against collision attacks.
-func New384() hash.Hash { return &state{rate: 104, outputLen: 48, dsbyte: 0x06} }
+// The returned hash is a *Hash.
+func New384() hash.Hash { return newHash(104, 48) }
 
 // New512 creates a new SHA3-512 hash.
 // Its generic security strength is 512 bits against preimage attacks,
 // and 256 bits against collision attacks.
-func New512() hash.Hash { return &state{rate: 72, outputLen: 64, dsbyte: 0x06} }
+// The returned hash is a *Hash.
+func New512() hash.Hash { return newHash(72, 64) }
+
+// Write absorbs more data into the hash's state. It never returns an error.
+func (h *Hash) Write(p []byte) (int, error) {
+	h.init()
+	return h.d.Write(p)
+}
+
+// WriteBits absorbs the first nbits bits of p, as in BitWriter.
+func (h *Hash) WriteBits(p []byte, nbits int) {
+	h.init()
+	h.d.WriteBits(p, nbits)
+}
+
+// Sum appends the digest of the data written so far to b, without changing
+// the state of the hash.
+func (h *Hash) Sum(b []byte) []byte {
+	h.init()
+	return h.d.Sum(b)
+}
+
+// SumInto writes the digest of the data written so far to dst, which must be
+// at least Size bytes long, without changing the state of the hash. It
+// doesn't allocate once the pool of sponges is warm.
+func (h *Hash) SumInto(dst []byte) {
+	h.init()
+	if len(dst) < h.d.outputLen {
+		panic("sha3: SumInto buffer too short")
+	}
+	h.d.sum(dst[:h.d.outputLen])
+}
+
+// Reset resets the hash to its initial state.
+func (h *Hash) Reset() {
+	h.init()
+	h.d.Reset()
+}
+
+// Size returns the size of the digest in bytes.
+func (h *Hash) Size() int {
+	h.init()
+	return h.d.outputLen
+}
+
+// BlockSize returns the rate of the sponge underlying the hash.
+func (h *Hash) BlockSize() int {
+	h.init()
+	return h.d.rate
+}
+
+// Clone returns a copy of the hash in its current state, which can then be
+// used independently.
+func (h *Hash) Clone() *Hash {
+	h.init()
+	ret := new(Hash)
+	ret.d.copyFrom(&h.d)
+	return ret
+}
+
+// Algorithm returns the identifier of the hash in the crypto package, such as
+// crypto.SHA3_256.
+func (h *Hash) Algorithm() crypto.Hash {
+	h.init()
+	switch h.d.outputLen {
+	case 28:
+		return crypto.SHA3_224
+	case 32:
+		return crypto.SHA3_256
+	case 48:
+		return crypto.SHA3_384
+	}
+	return crypto.SHA3_512
+}
+
+// MarshalBinary returns the state of the hash, in the format of crypto/sha3.
+// It fails if a partial byte has been written with WriteBits.
+func (h *Hash) MarshalBinary() ([]byte, error) {
+	h.init()
+	return h.d.appendBinary(make([]byte, 0, marshaledSize))
+}
+
+// AppendBinary appends the state of the hash to b, like MarshalBinary.
+func (h *Hash) AppendBinary(b []byte) ([]byte, error) {
+	h.init()
+	return h.d.appendBinary(b)
+}
+
+// UnmarshalBinary restores a state returned by MarshalBinary, or by the same
+// SHA-3 hash of crypto/sha3.
+func (h *Hash) UnmarshalBinary(b []byte) error {
+	h.init()
+	return h.d.unmarshalBinary(b)
+}
+
+// sumSHA3 writes the SHA-3 digest of data to digest, whose length is the
+// output size, with a sponge from the pool.
+func sumSHA3(digest, data []byte) {
+	d := getState(200-2*len(digest), len(digest), 0x06)
+	d.Write(data)
+	d.Read(digest)
+	putState(d)
+}
 
-// Sum224 returns the SHA3-224 digest of the data.
+// Sum224 returns the SHA3-224 digest of the data. It doesn't
+// allocate once the pool of sponges is warm.
 func Sum224(data []byte) (digest [28]byte) {
-	h := New224()
-	h.Write(data)
-	h.Sum(digest[:0])
+	sumSHA3(digest[:], data)
 	return
 }
 
-// Sum256 returns the SHA3-256 digest of the data.
+// Sum256 returns the SHA3-256 digest of the data. It doesn't
+// allocate once the pool of sponges is warm.
 func Sum256(data []byte) (digest [32]byte) {
-	h := New256()
-	h.Write(data)
-	h.Sum(digest[:0])
+	sumSHA3(digest[:], data)
 	return
 }
 
-// Sum384 returns the SHA3-384 digest of the data.
+// Sum384 returns the SHA3-384 digest of the data. It doesn't
+// allocate once the pool of sponges is warm.
 func Sum384(data []byte) (digest [48]byte) {
-	h := New384()
-	h.Write(data)
-	h.Sum(digest[:0])
+	sumSHA3(digest[:], data)
 	return
 }
 
-// Sum512 returns the SHA3-512 digest of the data.
+// Sum512 returns the SHA3-512 digest of the data. It doesn't
+// allocate once the pool of sponges is warm.
 func Sum512(data []byte) (digest [64]byte) {
-	h := New512()
-	h.Write(data)
-	h.Sum(digest[:0])
+	sumSHA3(digest[:], data)
 	return
 }
@@ -0,0 +1,184 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"crypto"
+	stdsha3 "crypto/sha3"
+	"encoding"
+	"hash"
+	"testing"
+)
+
+// The constructors can be passed to crypto/hmac and the like as they are.
+var _ = []func() hash.Hash{New224, New256, New384, New512}
+
+var hashConstructors = []struct {
+	name string
+	new  func() hash.Hash
+	std  func() hash.Hash
+	alg  crypto.Hash
+}{
+	{"SHA3-224", New224, func() hash.Hash { return stdsha3.New224() }, crypto.SHA3_224},
+	{"SHA3-256", New256, func() hash.Hash { return stdsha3.New256() }, crypto.SHA3_256},
+	{"SHA3-384", New384, func() hash.Hash { return stdsha3.New384() }, crypto.SHA3_384},
+	{"SHA3-512", New512, func() hash.Hash { return stdsha3.New512() }, crypto.SHA3_512},
+}
+
+// TestHashClone checks that clones of a hash that has absorbed a prefix hash
+// their own messages independently.
+func TestHashClone(t *testing.T) {
+	prefix, msg := sequentialBytes(1000), []byte("message")
+	for _, tc := range hashConstructors {
+		h := tc.new().(*Hash)
+		if got := h.Algorithm(); got != tc.alg {
+			t.Errorf("%s: Algorithm() = %v", tc.name, got)
+		}
+		h.Write(prefix)
+		for i := 0; i < 3; i++ {
+			c := h.Clone()
+			c.Write(msg[:i])
+			want := tc.new()
+			want.Write(prefix)
+			want.Write(msg[:i])
+			if got, want := c.Sum(nil), want.Sum(nil); !bytes.Equal(got, want) {
+				t.Errorf("%s, clone %d: got %x, want %x", tc.name, i, got, want)
+			}
+		}
+	}
+}
+
+// TestHashZeroValue checks that the zero value is SHA3-256, like that of
+// crypto/sha3, including when it's first used to restore a state.
+func TestHashZeroValue(t *testing.T) {
+	msg := []byte("message")
+	want := Sum256(msg)
+
+	var h Hash
+	if h.Size() != 32 || h.BlockSize() != 136 || h.Algorithm() != crypto.SHA3_256 {
+		t.Errorf("zero Hash has size %d, block size %d and algorithm %v", h.Size(), h.BlockSize(), h.Algorithm())
+	}
+	h.Write(msg)
+	if got := h.Sum(nil); !bytes.Equal(got, want[:]) {
+		t.Errorf("zero Hash: got %x, want %x", got, want)
+	}
+
+	std := stdsha3.New256()
+	std.Write(msg)
+	state, err := std.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u Hash
+	if err := u.UnmarshalBinary(state); err != nil {
+		t.Fatal(err)
+	}
+	if got := u.Sum(nil); !bytes.Equal(got, want[:]) {
+		t.Errorf("zero Hash after UnmarshalBinary: got %x, want %x", got, want)
+	}
+}
+
+// TestHashMarshal checks that the marshaled states are those of crypto/sha3,
+// and that states marshaled by either can be restored by the other.
+func TestHashMarshal(t *testing.T) {
+	msg := sequentialBytes(500)
+	testUnalignedAndGeneric(t, func(impl string) {
+		for _, tc := range hashConstructors {
+			rate := tc.new().BlockSize()
+			for _, n := range []int{0, 1, rate - 1, rate, rate + 9, 2*rate + 100} {
+				h, std := tc.new().(*Hash), tc.std()
+				h.Write(msg[:n])
+				std.Write(msg[:n])
+				got, err := h.MarshalBinary()
+				if err != nil {
+					t.Fatalf("%s (%s), %d bytes: %v", tc.name, impl, n, err)
+				}
+				want, err := std.(encoding.BinaryMarshaler).MarshalBinary()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("%s (%s), %d bytes: got state %x, want %x", tc.name, impl, n, got, want)
+				}
+
+				restored := tc.new().(*Hash)
+				if err := restored.UnmarshalBinary(want); err != nil {
+					t.Fatalf("%s (%s), %d bytes: %v", tc.name, impl, n, err)
+				}
+				restored.Write(msg[n:])
+				h.Write(msg[n:])
+				if !bytes.Equal(restored.Sum(nil), h.Sum(nil)) {
+					t.Errorf("%s (%s), %d bytes: restored hash differs", tc.name, impl, n)
+				}
+			}
+		}
+	})
+}
+
+// TestShakeMarshal checks the marshaling of squeezing sponges against
+// crypto/sha3. At the end of a block, crypto/sha3 saves the state before
+// the next permutation and sha3_fast after it, so there only the restored
+// states are compared.
+func TestShakeMarshal(t *testing.T) {
+	testUnalignedAndGeneric(t, func(impl string) {
+		for _, n := range []int{0, 1, 135, 136, 300} {
+			d, std := NewShake256().(*state), stdsha3.NewSHAKE256()
+			d.Write([]byte("squeeze"))
+			std.Write([]byte("squeeze"))
+			d.Read(make([]byte, n))
+			std.Read(make([]byte, n))
+			got, err := d.appendBinary(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := std.MarshalBinary()
+			if n%136 != 0 && !bytes.Equal(got, want) {
+				t.Errorf("%s, %d bytes read: got state %x, want %x", impl, n, got, want)
+			}
+
+			restored, stdRestored := NewShake256().(*state), stdsha3.NewSHAKE256()
+			if err := restored.unmarshalBinary(want); err != nil {
+				t.Fatal(err)
+			}
+			if err := stdRestored.UnmarshalBinary(got); err != nil {
+				t.Fatal(err)
+			}
+			wantOut := make([]byte, 200)
+			std.Read(wantOut)
+			for name, r := range map[string]interface{ Read([]byte) (int, error) }{
+				"sha3_fast": restored, "crypto/sha3": stdRestored,
+			} {
+				gotOut := make([]byte, 200)
+				r.Read(gotOut)
+				if !bytes.Equal(gotOut, wantOut) {
+					t.Errorf("%s, %d bytes read: output of the state restored by %s is %x, want %x", impl, n, name, gotOut, wantOut)
+				}
+			}
+		}
+	})
+}
+
+func TestHashMarshalInvalid(t *testing.T) {
+	h := New256().(*Hash)
+	h.WriteBits([]byte{1}, 3)
+	if _, err := h.MarshalBinary(); err == nil {
+		t.Errorf("marshaled a hash holding a partial byte")
+	}
+	saved, err := New256().(*Hash).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := New512().(*Hash).UnmarshalBinary(saved); err == nil {
+		t.Errorf("restored a SHA3-256 state into SHA3-512")
+	}
+	if err := New256().(*Hash).UnmarshalBinary(saved[1:]); err == nil {
+		t.Errorf("restored a truncated state")
+	}
+	shake, _ := NewShake256().(*state).appendBinary(nil)
+	if err := New256().(*Hash).UnmarshalBinary(shake); err == nil {
+		t.Errorf("restored a SHAKE256 state into SHA3-256")
+	}
+	saved[len(saved)-2] = byte(h.BlockSize() + 1)
+	if err := New256().(*Hash).UnmarshalBinary(saved); err == nil {
+		t.Errorf("restored a state with an invalid position")
+	}
+}
@@ -0,0 +1,121 @@
+package sha3_fast
+
+// This file implements HMAC (RFC 2104) with the SHA-3 hashes, as in FIPS 198-1
+// and NIST SP 800-224, and PBKDF2 (RFC 8018) on top of it. The sponges
+// keyed with the inner and outer padded keys are computed once, and restored
+// for every message, so that PBKDF2 only needs the permutations of the
+// messages themselves and doesn't allocate in its iterations.
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// hmacState is an HMAC-SHA3 instance.
+type hmacState struct {
+	inner, outer *state // the sponges after absorbing the padded keys
+	cur          *state // the inner sponge absorbing the message
+	tmp          *state // scratch space for Sum
+	sum          [64]byte
+}
+
+// newSHA3State returns the sponge of the SHA3 hash whose output is size bytes
+// long, panicking if there isn't one.
+func newSHA3State(size int) *state {
+	switch size {
+	case 28, 32, 48, 64:
+		return newState(200-2*size, size, 0x06)
+	}
+	panic("sha3: invalid SHA-3 output size")
+}
+
+// newHMAC returns an HMAC instance keyed with key, on the SHA3 hash whose
+// output is size bytes long.
+func newHMAC(size int, key []byte) *hmacState {
+	h := &hmacState{inner: newSHA3State(size), outer: newSHA3State(size)}
+	rate := h.inner.rate
+	if len(key) > rate {
+		// Keys longer than a block are hashed first.
+		d := newSHA3State(size)
+		d.Write(key)
+		d.Read(h.sum[:size])
+		key = h.sum[:size]
+	}
+	var pad [maxRate]byte
+	copy(pad[:], key)
+	for i := range pad[:rate] {
+		pad[i] ^= 0x36
+	}
+	h.inner.Write(pad[:rate])
+	for i := range pad[:rate] {
+		pad[i] ^= 0x36 ^ 0x5c
+	}
+	h.outer.Write(pad[:rate])
+	h.cur, h.tmp = h.inner.clone(), h.inner.clone()
+	return h
+}
+
+// NewHMAC returns an HMAC hash keyed with key on SHA3-224, SHA3-256, SHA3-384
+// or SHA3-512 according to size, the size of its output in bytes. It panics
+// if size isn't 28, 32, 48 or 64. Reset is cheap, as the sponges keyed with
+// the padded keys are kept.
+func NewHMAC(size int, key []byte) hash.Hash {
+	return newHMAC(size, key)
+}
+
+func (h *hmacState) Size() int      { return h.inner.outputLen }
+func (h *hmacState) BlockSize() int { return h.inner.rate }
+
+func (h *hmacState) Reset() { h.cur.copyFrom(h.inner) }
+
+func (h *hmacState) Write(p []byte) (int, error) { return h.cur.Write(p) }
+
+// sumInto writes the MAC of the message absorbed by d, which it consumes, to
+// dst.
+func (h *hmacState) sumInto(d *state, dst []byte) {
+	size := h.Size()
+	d.Read(h.sum[:size])
+	d.copyFrom(h.outer)
+	d.Write(h.sum[:size])
+	d.Read(dst[:size])
+}
+
+func (h *hmacState) Sum(in []byte) []byte {
+	var mac [64]byte
+	h.tmp.copyFrom(h.cur)
+	h.sumInto(h.tmp, mac[:])
+	return append(in, mac[:h.Size()]...)
+}
+
+// PBKDF2 derives a key of keyLen bytes from the password and salt with
+// PBKDF2 using HMAC on the SHA3 hash whose output is size bytes long, and
+// iter iterations. It panics if size isn't 28, 32, 48 or 64. It is equivalent
+// to pbkdf2.Key from golang.org/x/crypto with crypto/hmac, but doesn't
+// allocate in the iterations.
+func PBKDF2(password, salt []byte, iter, keyLen, size int) []byte {
+	h := newHMAC(size, password)
+	d := h.tmp
+	var u, t [64]byte
+	var counter [4]byte
+	out := make([]byte, 0, keyLen+size)
+	for block := uint32(1); len(out) < keyLen; block++ {
+		// U_1 = PRF(password, salt || INT(block))
+		d.copyFrom(h.inner)
+		d.Write(salt)
+		binary.BigEndian.PutUint32(counter[:], block)
+		d.Write(counter[:])
+		h.sumInto(d, u[:])
+		t = u
+		for n := 1; n < iter; n++ {
+			// U_n = PRF(password, U_{n-1})
+			d.copyFrom(h.inner)
+			d.Write(u[:size])
+			h.sumInto(d, u[:])
+			for i := range t[:size] {
+				t[i] ^= u[i]
+			}
+		}
+		out = append(out, t[:size]...)
+	}
+	return out[:keyLen]
+}
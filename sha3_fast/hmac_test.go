@@ -0,0 +1,226 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/hex"
+	"hash"
+	"testing"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// hmacSizes are the output sizes of the SHA3 hashes, with their constructors.
+var hmacSizes = []struct {
+	size int
+	new  func() hash.Hash
+}{
+	{28, New224},
+	{32, New256},
+	{48, New384},
+	{64, New512},
+}
+
+// hmacTests are the keys and messages of the test cases 1, 2, 3, 4, 6 and 7
+// of RFC 4231, plus one with a key longer than every rate. The RFC only gives
+// results for SHA-2, so the expected MACs were computed with crypto/hmac and
+// crypto/sha3 from the standard library, for SHA3-224, SHA3-256, SHA3-384 and
+// SHA3-512 in that order.
+var hmacTests = []struct {
+	key, data []byte
+	want      [4]string
+}{
+	{
+		bytes.Repeat([]byte{0x0b}, 20),
+		[]byte("Hi There"),
+		[4]string{
+			"3b16546bbc7be2706a031dcafd56373d9884367641d8c59af3c860f7",
+			"ba85192310dffa96e2a3a40e69774351140bb7185e1202cdcc917589f95e16bb",
+			"68d2dcf7fd4ddd0a2240c8a437305f61fb7334cfb5d0226e1bc27dc10a2e723a20d370b47743130e26ac7e3d532886bd",
+			"eb3fbd4b2eaab8f5c504bd3a41465aacec15770a7cabac531e482f860b5ec7ba47ccb2c6f2afce8f88d22b6dc61380f23a668fd3888bb80537c0a0b86407689e",
+		},
+	},
+	{
+		[]byte("Jefe"),
+		[]byte("what do ya want for nothing?"),
+		[4]string{
+			"7fdb8dd88bd2f60d1b798634ad386811c2cfc85bfaf5d52bbace5e66",
+			"c7d4072e788877ae3596bbb0da73b887c9171f93095b294ae857fbe2645e1ba5",
+			"f1101f8cbf9766fd6764d2ed61903f21ca9b18f57cf3e1a23ca13508a93243ce48c045dc007f26a21b3f5e0e9df4c20a",
+			"5a4bfeab6166427c7a3647b747292b8384537cdb89afb3bf5665e4c5e709350b287baec921fd7ca0ee7a0c31d022a95e1fc92ba9d77df883960275beb4e62024",
+		},
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 20),
+		bytes.Repeat([]byte{0xdd}, 50),
+		[4]string{
+			"676cfc7d16153638780390692be142d2df7ce924b909c0c08dbfdc1a",
+			"84ec79124a27107865cedd8bd82da9965e5ed8c37b0ac98005a7f39ed58a4207",
+			"275cd0e661bb8b151c64d288f1f782fb91a8abd56858d72babb2d476f0458373b41b6ab5bf174bec422e53fc3135ac6e",
+			"309e99f9ec075ec6c6d475eda1180687fcf1531195802a99b5677449a8625182851cb332afb6a89c411325fbcbcd42afcb7b6e5aab7ea42c660f97fd8584bf03",
+		},
+	},
+	{
+		sequentialBytes(26)[1:],
+		bytes.Repeat([]byte{0xcd}, 50),
+		[4]string{
+			"a9d7685a19c4e0dbd9df2556cc8a7d2a7733b67625ce594c78270eeb",
+			"57366a45e2305321a4bc5aa5fe2ef8a921f6af8273d7fe7be6cfedb3f0aea6d7",
+			"3a5d7a879702c086bc96d1dd8aa15d9c46446b95521311c606fdc4e308f4b984da2d0f9449b3ba8425ec7fb8c31bc136",
+			"b27eab1d6e8d87461c29f7f5739dd58e98aa35f8e823ad38c5492a2088fa0281993bbfff9a0e9c6bf121ae9ec9bb09d84a5ebac817182ea974673fb133ca0d1d",
+		},
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 131),
+		[]byte("Test Using Larger Than Block-Size Key - Hash Key First"),
+		[4]string{
+			"b4a1f04c00287a9b7f6075b313d279b833bc8f75124352d05fb9995f",
+			"ed73a374b96c005235f948032f09674a58c0ce555cfc1f223b02356560312c3b",
+			"0fc19513bf6bd878037016706a0e57bc528139836b9a42c3d419e498e0e1fb9616fd669138d33a1105e07c72b6953bcc",
+			"00f751a9e50695b090ed6911a4b65524951cdc15a73a5d58bb55215ea2cd839ac79d2b44a39bafab27e83fde9e11f6340b11d991b1b91bf2eee7fc872426c3a4",
+		},
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 131),
+		[]byte("This is a test using a larger than block-size key and a larger than block-size data. The key needs to be hashed before being used by the HMAC algorithm."),
+		[4]string{
+			"05d8cd6d00faea8d1eb68ade28730bbd3cbab6929f0a086b29cd62a0",
+			"65c5b06d4c3de32a7aef8763261e49adb6e2293ec8e7c61e8de61701fc63e123",
+			"026fdf6b50741e373899c9f7d5406d4eb09fc6665636fc1a530029ddf5cf3ca5a900edce01f5f61e2f408cdf2fd3e7e8",
+			"38a456a004bd10d32c9ab8336684112862c3db61adcca31829355eaf46fd5c73d06a1f0d13fec9a652fb3811b577b1b1d1b9789f97ae5b83c6f44dfcf1d67eba",
+		},
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 200),
+		[]byte("Test Using Larger Than Block-Size Key - Hash Key First"),
+		[4]string{
+			"5e73d57bd011f0f92fef3c3b92ea4bcb4821c6d83c37db34f29e0760",
+			"49ad92b02124fdac9627ae45e008a696182ab6bfb8470457777c744aeb9df06f",
+			"3e7b62d091d75f484892bc2ed26d7b0ed37c9529f0227197cc8522971eb6f7215dd4e0cc6ea306987e0cbfe914f3a916",
+			"fafc7b7fe3332ce153966b27f6586fa5b49ec5d8dff3d7fd26a011451ca4c9de437913879159d9c5181a9a6f377ef18b48399756decea695b04fe90a9d3b93d1",
+		},
+	},
+}
+
+func TestHMAC(t *testing.T) {
+	testUnalignedAndGeneric(t, func(impl string) {
+		for i, tc := range hmacTests {
+			for j, s := range hmacSizes {
+				h := NewHMAC(s.size, tc.key)
+				if h.Size() != s.size || h.BlockSize() != 200-2*s.size {
+					t.Errorf("HMAC-SHA3-%d: got Size %d and BlockSize %d", s.size*8, h.Size(), h.BlockSize())
+				}
+				// Reusing the instance must give the same MAC each time,
+				// and Sum mustn't disturb the message being absorbed.
+				for k := 0; k < 2; k++ {
+					h.Write(tc.data[:len(tc.data)/2])
+					h.Sum(nil)
+					h.Write(tc.data[len(tc.data)/2:])
+					if got := hex.EncodeToString(h.Sum(nil)); got != tc.want[j] {
+						t.Errorf("case %d, HMAC-SHA3-%d (%s), use %d: got %s, want %s", i, s.size*8, impl, k, got, tc.want[j])
+					}
+					h.Reset()
+				}
+			}
+		}
+	})
+}
+
+// TestHMACCryptoHMAC checks NewHMAC against crypto/hmac on the SHA3 hashes
+// for keys of every length around the rates.
+func TestHMACCryptoHMAC(t *testing.T) {
+	msg := sequentialBytes(300)
+	for _, s := range hmacSizes {
+		for keyLen := 0; keyLen <= 200; keyLen++ {
+			key := msg[100 : 100+keyLen]
+			want := hmac.New(s.new, key)
+			want.Write(msg[:keyLen])
+			got := NewHMAC(s.size, key)
+			got.Write(msg[:keyLen])
+			if !bytes.Equal(got.Sum(nil), want.Sum(nil)) {
+				t.Errorf("HMAC-SHA3-%d with a %d byte key: got %x, want %x", s.size*8, keyLen, got.Sum(nil), want.Sum(nil))
+			}
+		}
+	}
+}
+
+func TestHMACInvalidSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewHMAC accepted a 20 byte output")
+		}
+	}()
+	NewHMAC(20, nil)
+}
+
+// pbkdf2Tests are the inputs of the PBKDF2 test cases of RFC 6070, plus a few
+// for the other output sizes. The RFC only gives results for SHA-1, so the
+// expected keys were computed with crypto/pbkdf2, crypto/hmac and crypto/sha3
+// from the standard library.
+var pbkdf2Tests = []struct {
+	password, salt     string
+	iter, keyLen, size int
+	want               string
+}{
+	{"password", "salt", 1, 32, 32, "94613f3ee2ea730e0b06754f3fc816d4f87c9be9cbd8556b5d59b52330e333a8"},
+	{"password", "salt", 2, 32, 32, "4c915baedd1773383e77fcfe38114ca7514010adec24b47290ec170208423f76"},
+	{"password", "salt", 4096, 32, 32, "778b6e237a0f49621549ff70d218d2080756b9fb38d71b5d7ef447fa2254af61"},
+	{"passwordPASSWORDpassword", "saltSALTsaltSALTsaltSALTsaltSALTsalt", 4096, 40, 32, "7aef8f1ad8c7f12205334f624d4af9e2863121618f7a0b3209bef3934801c39feac24ef0ac6a5c25"},
+	{"pass\x00word", "sa\x00lt", 4096, 16, 32, "98e5503130ffdd69603da78cbb12e9be"},
+	{"password", "salt", 1000, 100, 64, "e697001cf40fe4623eb67df2ddab791a499451234957133097deffce766fc9839e4642de2a1cfea8307d98bde6995bab8cf70453dc8eab92fcba0a02a2ae026e201a0b0caab8218cb5c494ee928d24f2c05f444313912622628ee8b3f19ded202f57e348"},
+	{"password", "salt", 1000, 70, 28, "2c63ae34f3c11883e945e556cfe40c4d73541622785040f2c4296fa53966d6c065a55e7286113ee1de97fbc0eb8d25b1da09aa69b645312ca404f5d2bd4a2768b84089f17d48"},
+}
+
+func TestPBKDF2(t *testing.T) {
+	testUnalignedAndGeneric(t, func(impl string) {
+		for i, tc := range pbkdf2Tests {
+			got := PBKDF2([]byte(tc.password), []byte(tc.salt), tc.iter, tc.keyLen, tc.size)
+			if hex.EncodeToString(got) != tc.want {
+				t.Errorf("case %d (%s): got %x, want %s", i, impl, got, tc.want)
+			}
+		}
+	})
+}
+
+// TestPBKDF2CryptoHMAC checks PBKDF2 against golang.org/x/crypto/pbkdf2 with
+// crypto/hmac, for key lengths around multiples of the output sizes.
+func TestPBKDF2CryptoHMAC(t *testing.T) {
+	password, salt := sequentialBytes(150), sequentialBytes(40)
+	for _, s := range hmacSizes {
+		for keyLen := 1; keyLen <= 3*s.size+1; keyLen += s.size - 1 {
+			want := pbkdf2.Key(password, salt, 3, keyLen, s.new)
+			if got := PBKDF2(password, salt, 3, keyLen, s.size); !bytes.Equal(got, want) {
+				t.Errorf("SHA3-%d, %d bytes: got %x, want %x", s.size*8, keyLen, got, want)
+			}
+		}
+	}
+}
+
+// TestPBKDF2Allocations checks that PBKDF2 doesn't allocate in its
+// iterations.
+func TestPBKDF2Allocations(t *testing.T) {
+	password, salt := []byte("password"), []byte("salt")
+	allocs := func(iter int) float64 {
+		return testing.AllocsPerRun(10, func() { PBKDF2(password, salt, iter, 32, 32) })
+	}
+	if one, many := allocs(1), allocs(100); many != one {
+		t.Errorf("PBKDF2 made %v allocations with 1 iteration and %v with 100", one, many)
+	}
+}
+
+func BenchmarkPBKDF2(b *testing.B) {
+	password, salt := []byte("password"), []byte("salt")
+	for i := 0; i < b.N; i++ {
+		PBKDF2(password, salt, 4096, 32, 32)
+	}
+}
+
+// BenchmarkPBKDF2CryptoHMAC measures the same derivation as BenchmarkPBKDF2
+// with golang.org/x/crypto/pbkdf2 and crypto/hmac, which rehash the padded
+// keys for every iteration.
+func BenchmarkPBKDF2CryptoHMAC(b *testing.B) {
+	password, salt := []byte("password"), []byte("salt")
+	for i := 0; i < b.N; i++ {
+		pbkdf2.Key(password, salt, 4096, 32, New256)
+	}
+}
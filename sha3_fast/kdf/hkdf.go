@@ -0,0 +1,64 @@
+package kdf
+
+import (
+	"errors"
+	"hash"
+	"io"
+)
+
+// errHKDFLimit is returned when more than 255 blocks are read from HKDF.
+var errHKDFLimit = errors.New("kdf: HKDF output limit reached")
+
+// HKDFExtract returns the pseudorandom key of HKDF-Extract, the HMAC of
+// secret keyed with salt. A nil salt is the default salt of h.Size() zeros.
+func HKDFExtract(h Hash, secret, salt []byte) []byte {
+	mac := h.hmac(salt)
+	mac.Write(secret)
+	return mac.Sum(nil)
+}
+
+// HKDFExpand returns a Reader of the output of HKDF-Expand with the
+// pseudorandom key prk and the context info. At most 255*h.Size() bytes can
+// be read from it, and a read that reaches past them returns the bytes left
+// along with an error.
+func HKDFExpand(h Hash, prk, info []byte) io.Reader {
+	return &hkdf{mac: h.hmac(prk), info: info}
+}
+
+// NewHKDF returns a Reader of the output of HKDF with the input keying
+// material secret, salt and info, i.e. HKDFExpand of HKDFExtract.
+func NewHKDF(h Hash, secret, salt, info []byte) io.Reader {
+	return HKDFExpand(h, HKDFExtract(h, secret, salt), info)
+}
+
+type hkdf struct {
+	mac     hash.Hash
+	info    []byte
+	counter int    // the number of blocks computed
+	prev    []byte // the last block
+	buf     []byte // the unread end of the last block
+}
+
+func (f *hkdf) Read(p []byte) (int, error) {
+	// Read what is left before the limit, and then report it.
+	var err error
+	if left := len(f.buf) + (255-f.counter)*f.mac.Size(); len(p) > left {
+		p, err = p[:left], errHKDFLimit
+	}
+	n := len(p)
+	for len(p) > 0 {
+		if len(f.buf) == 0 {
+			// T(i) = HMAC(PRK, T(i-1) | info | i)
+			f.counter++
+			f.mac.Reset()
+			f.mac.Write(f.prev)
+			f.mac.Write(f.info)
+			f.mac.Write([]byte{byte(f.counter)})
+			f.prev = f.mac.Sum(f.prev[:0])
+			f.buf = f.prev
+		}
+		copied := copy(p, f.buf)
+		p, f.buf = p[copied:], f.buf[copied:]
+	}
+	return n, err
+}
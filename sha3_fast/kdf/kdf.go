@@ -0,0 +1,73 @@
+// Package kdf implements key derivation functions on the SHA-3 family of
+// sha3_fast:
+//
+//   - HKDF (RFC 5869) with HMAC-SHA3,
+//   - the KDF in KMAC mode of NIST SP 800-108r1, and
+//   - the one-step KDF of NIST SP 800-56C Rev. 2, with SHA-3, HMAC-SHA3 or
+//     KMAC as its auxiliary function.
+//
+// The derivations built on a hash or HMAC produce their output block by block
+// and return an io.Reader, from which keys can be read one after the other.
+// Those built on KMAC take the length of the output as one of their inputs,
+// so they can't be streamed and return the whole output instead.
+package kdf
+
+import (
+	"hash"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+)
+
+// Hash is a SHA-3 hash function, used directly or in HMAC.
+type Hash int
+
+const (
+	SHA3_224 Hash = iota
+	SHA3_256
+	SHA3_384
+	SHA3_512
+)
+
+// Size returns the size of the output of h in bytes. It panics if h is
+// unknown.
+func (h Hash) Size() int {
+	switch h {
+	case SHA3_224:
+		return 28
+	case SHA3_256:
+		return 32
+	case SHA3_384:
+		return 48
+	case SHA3_512:
+		return 64
+	}
+	panic("kdf: unknown hash")
+}
+
+func (h Hash) new() hash.Hash {
+	switch h {
+	case SHA3_224:
+		return sha3.New224()
+	case SHA3_256:
+		return sha3.New256()
+	case SHA3_384:
+		return sha3.New384()
+	case SHA3_512:
+		return sha3.New512()
+	}
+	panic("kdf: unknown hash")
+}
+
+// hmac returns HMAC on h keyed with key.
+func (h Hash) hmac(key []byte) hash.Hash {
+	return sha3.NewHMAC(h.Size(), key)
+}
+
+// KMAC is a KMAC variant of NIST SP 800-185, named by its security strength
+// in bits.
+type KMAC int
+
+const (
+	KMAC128 KMAC = 128
+	KMAC256 KMAC = 256
+)
@@ -0,0 +1,314 @@
+package kdf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash"
+	"io"
+	"testing"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+	xhkdf "golang.org/x/crypto/hkdf"
+)
+
+// bytesRange returns the bytes from first to last.
+func bytesRange(first, last int) []byte {
+	b := make([]byte, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		b = append(b, byte(i))
+	}
+	return b
+}
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func readAll(t *testing.T, r io.Reader, n int) []byte {
+	out := make([]byte, n)
+	if _, err := io.ReadFull(r, out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestEncodings(t *testing.T) {
+	for _, tc := range []struct {
+		x           uint64
+		left, right string
+	}{
+		{0, "0100", "0001"},
+		{255, "01ff", "ff01"},
+		{256, "020100", "010002"},
+		{1<<64 - 1, "08ffffffffffffffff", "ffffffffffffffff08"},
+	} {
+		if got := hex.EncodeToString(leftEncode(tc.x)); got != tc.left {
+			t.Errorf("leftEncode(%d) = %s, want %s", tc.x, got, tc.left)
+		}
+		if got := hex.EncodeToString(rightEncode(tc.x)); got != tc.right {
+			t.Errorf("rightEncode(%d) = %s, want %s", tc.x, got, tc.right)
+		}
+	}
+}
+
+// TestKMAC checks KMAC against the samples of NIST SP 800-185.
+func TestKMAC(t *testing.T) {
+	key := bytesRange(0x40, 0x5f)
+	tag := []byte("My Tagged Application")
+	for i, tc := range []struct {
+		k    KMAC
+		data []byte
+		s    []byte
+		want string
+	}{
+		{KMAC128, bytesRange(0, 3), nil, "e5780b0d3ea6f7d3a429c5706aa43a00fadbd7d49628839e3187243f456ee14e"},
+		{KMAC128, bytesRange(0, 3), tag, "3b1fba963cd8b0b59e8c1a6d71888b7143651af8ba0a7070c0979e2811324aa5"},
+		{KMAC128, bytesRange(0, 199), tag, "1f5b4e6cca02209e0dcb5ca635b89a15e271ecc760071dfd805faa38f9729230"},
+		{KMAC256, bytesRange(0, 3), tag, "20c570c31346f703c9ac36c61c03cb64c3970d0cfc787e9b79599d273a68d2f7f69d4cc3de9d104a351689f27cf6f5951f0103f33f4f24871024d9c27773a8dd"},
+		{KMAC256, bytesRange(0, 199), nil, "75358cf39e41494e949707927cee0af20a3ff553904c86b08f21cc414bcfd691589d27cf5e15369cbbff8b9a4c2eb17800855d0235ff635da82533ec6b759b69"},
+		{KMAC256, bytesRange(0, 199), tag, "b58618f71f92e1d56c1b8c55ddd7cd188b97b4ca4d99831eb2699a837da2e4d970fbacfde50033aea585f1a2708510c32d07880801bd182898fe476876fc8965"},
+	} {
+		if got := hex.EncodeToString(tc.k.sum(key, tc.data, len(tc.want)/2, tc.s)); got != tc.want {
+			t.Errorf("sample %d: got %s, want %s", i+1, got, tc.want)
+		}
+	}
+}
+
+// The HKDF tests use the inputs of the test cases 1 to 3 of RFC 5869, which
+// only gives results for SHA-2. The expected values were computed with
+// crypto/hkdf and crypto/sha3 from the standard library.
+var hkdfTests = []struct {
+	h                  Hash
+	secret, salt, info []byte
+	prk, okm           string
+}{
+	{
+		SHA3_256, bytes.Repeat([]byte{0x0b}, 22), bytesRange(0, 12), bytesRange(0xf0, 0xf9),
+		"7d4194836f7a113a44677abc825640ade07af1c1d69a9a4b109b280a8fe54ef0",
+		"0c5160501d65021deaf2c14f5abce04c5bd2635abceeba61c2edb6e8ed72674900557728f2c9f2c4c179",
+	},
+	{
+		SHA3_256, bytesRange(0, 0x4f), bytesRange(0x60, 0xaf), bytesRange(0xb0, 0xff),
+		"addf31835b49366ac27734104d9f1865c1c2e7c8a2ebc1fed712808e4eab677c",
+		"3dc251e66c75da6560405ec5ac10e17d851eedfbfdc13feafbec16964c25d021bd971465a3e9c615f27769019e3f0407d84986fb0ba24e729c99834624baa21cb623dc0098f430d52e18bbdf694df4edd8b2",
+	},
+	{
+		SHA3_256, bytes.Repeat([]byte{0x0b}, 22), nil, nil,
+		"b899e6e4b88a35f9f5d618f48b424c313f9704012763eb6295414d673365928a",
+		"bc1342cdd75c05e8b0c3ae609ce4410684d197232875073499b30cdfe2de2853c1c1bed63d725e885e78",
+	},
+	{
+		SHA3_512, bytes.Repeat([]byte{0x0b}, 22), bytesRange(0, 12), bytesRange(0xf0, 0xf9),
+		"e1c543094f64f3d6c6658a94a94e3818ba13d0b3e77074b80f88f32e6b8433b703536cb500753967fae2ea977e11e4dd4f45389807cdf255b395e46807c87d5d",
+		"40e9f17e9bf2ef99425c2b23ccdf20a018ea5513f9ae68e1ea8c626deb57dfa4d56c27ccf2a2a24488a5",
+	},
+	{
+		SHA3_512, bytesRange(0, 0x4f), bytesRange(0x60, 0xaf), bytesRange(0xb0, 0xff),
+		"bc138b5ec5f398198e333105a8ed3c2e775016e53c8de21aaddc2d776964e14e9e1fd19bf5678aa97c2a57427d1eeac6e8ca44ddbae018a47dc18fe8201efdc6",
+		"3adf31011245f82cc6b5c3b2ea31fe2a9b855b425c3ecdd8da4a3fc5d0c3563f63bbdedf7ca912d2e98cbc853d978066ab177f19a7349e3982549b82a307e2113891691f2536ce45eb5ddf9b5175859ce8d5",
+	},
+	{
+		SHA3_512, bytes.Repeat([]byte{0x0b}, 22), nil, nil,
+		"37a48c72dce8c34bf1a08356c929133ea60a20c6c2eb3ce26d2c3ce6b0e2385572e82fc77418ace2f6df0419eacafc847fdf283b0324163d7d88265a8e7e4992",
+		"38bd71e45b397b775b563365a33258a6fd83abc1e86acf042f0723c2b68ebf073a75c34c69328835ee4c",
+	},
+}
+
+func TestHKDF(t *testing.T) {
+	for i, tc := range hkdfTests {
+		if prk := hex.EncodeToString(HKDFExtract(tc.h, tc.secret, tc.salt)); prk != tc.prk {
+			t.Errorf("case %d: got PRK %s, want %s", i, prk, tc.prk)
+		}
+		okm := readAll(t, NewHKDF(tc.h, tc.secret, tc.salt, tc.info), len(tc.okm)/2)
+		if hex.EncodeToString(okm) != tc.okm {
+			t.Errorf("case %d: got OKM %x, want %s", i, okm, tc.okm)
+		}
+	}
+}
+
+// TestHKDFCrypto checks HKDF against golang.org/x/crypto/hkdf on the SHA3
+// hashes, reading the output in chunks of every size.
+func TestHKDFCrypto(t *testing.T) {
+	secret, salt, info := bytesRange(0, 40), bytesRange(50, 60), []byte("info")
+	for _, tc := range []struct {
+		h   Hash
+		new func() hash.Hash
+	}{
+		{SHA3_224, sha3.New224},
+		{SHA3_256, sha3.New256},
+		{SHA3_384, sha3.New384},
+		{SHA3_512, sha3.New512},
+	} {
+		want := readAll(t, xhkdf.New(tc.new, secret, salt, info), 255*tc.h.Size())
+		for chunk := 1; chunk <= 2*tc.h.Size()+1; chunk++ {
+			r := NewHKDF(tc.h, secret, salt, info)
+			var got []byte
+			for len(got) < len(want) {
+				n := chunk
+				if len(want)-len(got) < n {
+					n = len(want) - len(got)
+				}
+				got = append(got, readAll(t, r, n)...)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("SHA3-%d in chunks of %d: output differs from x/crypto/hkdf", tc.h.Size()*8, chunk)
+			}
+			if _, err := r.Read(make([]byte, 1)); err != errHKDFLimit {
+				t.Errorf("SHA3-%d: reading past the limit returned %v", tc.h.Size()*8, err)
+			}
+		}
+	}
+}
+
+// TestHKDFLimit checks that a read reaching past the 255 blocks of HKDF
+// returns the bytes left along with the error, like x/crypto/hkdf.
+func TestHKDFLimit(t *testing.T) {
+	secret, salt, info := bytesRange(0, 40), bytesRange(50, 60), []byte("info")
+	for _, h := range []Hash{SHA3_224, SHA3_512} {
+		limit := 255 * h.Size()
+		want := readAll(t, NewHKDF(h, secret, salt, info), limit)
+
+		r := NewHKDF(h, secret, salt, info)
+		readAll(t, r, limit-5)
+		got := make([]byte, 10)
+		if n, err := r.Read(got); n != 5 || err != errHKDFLimit {
+			t.Errorf("SHA3-%d: reading past the limit returned %d, %v", h.Size()*8, n, err)
+		}
+		if !bytes.Equal(got[:5], want[limit-5:]) {
+			t.Errorf("SHA3-%d: got the last bytes %x, want %x", h.Size()*8, got[:5], want[limit-5:])
+		}
+		if n, err := r.Read(got); n != 0 || err != errHKDFLimit {
+			t.Errorf("SHA3-%d: reading after the limit returned %d, %v", h.Size()*8, n, err)
+		}
+
+		// A single read of more than the limit gets all of it.
+		all := make([]byte, limit+1)
+		if n, err := NewHKDF(h, secret, salt, info).Read(all); n != limit || err != errHKDFLimit || !bytes.Equal(all[:limit], want) {
+			t.Errorf("SHA3-%d: reading %d bytes at once returned %d, %v", h.Size()*8, limit+1, n, err)
+		}
+	}
+}
+
+// The one-step KDF and KMAC KDF tests use inputs made up for them, and the
+// expected values were computed with crypto/hmac and crypto/sha3 from the
+// standard library, as NIST doesn't publish sample values for these KDFs.
+var (
+	oneStepZ         = bytesRange(0, 31)
+	oneStepSalt      = bytesRange(0x80, 0x8f)
+	oneStepFixedInfo = []byte("fixed info")
+)
+
+func TestOneStep(t *testing.T) {
+	for _, tc := range []struct {
+		h                           Hash
+		hash, hmac, hmacDefaultSalt string
+	}{
+		{
+			SHA3_224,
+			"99ebc6c09dec8accbbf034214c1fcf74240b316829c7ad212f00129d11df42b84453006a6051fa23b2b2a9d80e189d2067499a244669919e384a59727bcc2efcedbcfc2388a3fbda7cbe9b582e94e2bd735515cfd44cb69d1b6c8d08e78acd54dcad8af5",
+			"b31c8b3cb3d7eb73ddd3da78a7110c38aec2e45000e715a8338061c28a86e1f28f65b864473d2079c813cfcaf0bb5978f6eeb0c05456904154cee59d8f81a29f7ba1219becfcfc6ce5cdde01eb959b45c202049d36a8e669afc1b7a5dcc60a469bce5cd9",
+			"cf6c525f09e3d7574c3689f55cceb2dfffc6b3f40bae9d6ee6dab36aac738605f95ab30f7f9d873e",
+		},
+		{
+			SHA3_256,
+			"3487390249dcb9d97521b2d424b0b48c0242d03b330d395f0d677a69321a60ca542955f4ceb3172f200ef0d56486d096ff2a3ed5b9e4e34d50cdd51758b08a15f357d726960bfcc57804bf8296ba05c6b39a3a06d3be0aed9ca38a82b74b6b382c9681fa",
+			"af5c525ff0022d19100d3308dbe7a824a6d9dfe73455a453389df79e73a1069cb6aef38a6667546e602f1ac5bed0aa8a515dedbc42df242e43601484bfb585db484b147c8a7141569685c8332d0aea202ad4c6cb4239857707dbcac1d22462a641e48f02",
+			"495533e9a4a70c0588c8b34a977cf6a2d79c18dafe25a2b35aba0fe79bee686c750a059ab549f96a",
+		},
+		{
+			SHA3_384,
+			"e3e8c6e6d23011883b8e9ed9d516088c3de04ce600a32baa259955a722e234e16c306c150224dd6e0658fe8317c34d70eb9660e779a1a3e86f24c689d95c0d1ac324f017e8f0f92d5e7c5f0f2ca6db4bfe8642e1d55b388d8931b2b0eb09eeb274734595",
+			"f45b524dd650db36f51e9a1771e8750a59b367569a4a97c86537739cd0815bef62fd918916ef9f18b7d97bc446a537579fe2db14cfdfba7da0f41eab760ba878cf7d79860b55a163fbaa85a430caa91bf68650d92c2327edc89104ed877de25d0dcf836c",
+			"341af942b09b2d3f02f1c9c132f7eeb150719f08435b6f70464022ebd8080f01d8e408bde26e6dab",
+		},
+		{
+			SHA3_512,
+			"7b916bc46f19169a1595b5d0bba6c7f13a160010f5d100c0e08659bddc1c2a549e8173f4567eb037a3ebfef556170b25376a326aa88eca173685cf06506d41c861062a5338ee8958ee890bc9bb339d358cac9b79eb81a39b6694c78a7331676fee7f3870",
+			"9d2713d8ad6248af1b370c045b73bf7e99d8e3dcc731b4c9ded57806410de4aca8202e21870d924bce957d6faac1010263a085d95472a385bf3200ba944480cc50bc58da6416d4c223f8dc86bf0520b0ede75217038dfca5fffe9cab656d01425ca52137",
+			"ca2127e7d8ad9e53cf0c18b4e4b8ff5bfd35a9a908eb74769a1392f1187c4510df4ef0ea27864f71",
+		},
+	} {
+		name := tc.h.Size() * 8
+		if got := readAll(t, OneStep(tc.h, oneStepZ, oneStepFixedInfo), len(tc.hash)/2); hex.EncodeToString(got) != tc.hash {
+			t.Errorf("SHA3-%d: got %x, want %s", name, got, tc.hash)
+		}
+		if got := readAll(t, OneStepHMAC(tc.h, oneStepZ, oneStepSalt, oneStepFixedInfo), len(tc.hmac)/2); hex.EncodeToString(got) != tc.hmac {
+			t.Errorf("HMAC-SHA3-%d: got %x, want %s", name, got, tc.hmac)
+		}
+		if got := readAll(t, OneStepHMAC(tc.h, oneStepZ, nil, oneStepFixedInfo), len(tc.hmacDefaultSalt)/2); hex.EncodeToString(got) != tc.hmacDefaultSalt {
+			t.Errorf("HMAC-SHA3-%d with the default salt: got %x, want %s", name, got, tc.hmacDefaultSalt)
+		}
+
+		// Reading in pieces gives the same output.
+		r := OneStep(tc.h, oneStepZ, oneStepFixedInfo)
+		var got []byte
+		for _, n := range []int{1, tc.h.Size() - 1, 5, 2} {
+			got = append(got, readAll(t, r, n)...)
+		}
+		if want := decodeHex(tc.hash)[:len(got)]; !bytes.Equal(got, want) {
+			t.Errorf("SHA3-%d read in pieces: got %x, want %x", name, got, want)
+		}
+	}
+}
+
+func TestOneStepLimit(t *testing.T) {
+	r := OneStep(SHA3_256, oneStepZ, oneStepFixedInfo).(*oneStep)
+	r.counter = 1<<32 - 2
+	last := sha3.Sum256(append(append([]byte{0xff, 0xff, 0xff, 0xff}, oneStepZ...), oneStepFixedInfo...))
+	readAll(t, r, 1)
+	got := make([]byte, 40)
+	if n, err := r.Read(got); n != 31 || err != errOneStepLimit {
+		t.Errorf("reading past the last block returned %d, %v", n, err)
+	}
+	if !bytes.Equal(got[:31], last[1:]) {
+		t.Errorf("got the end of the last block %x, want %x", got[:31], last[1:])
+	}
+	if n, err := r.Read(make([]byte, 1)); n != 0 || err != errOneStepLimit {
+		t.Errorf("reading after the limit returned %d, %v", n, err)
+	}
+}
+
+func TestOneStepKMAC(t *testing.T) {
+	for _, tc := range []struct {
+		k                 KMAC
+		want, defaultSalt string
+	}{
+		{
+			KMAC128,
+			"ec1dd10ff4cef8ce53ae521a2e565c30a59890df79772744826a28f812085d0f294193af67e15ff44ef9acc78d4466465923",
+			"3f4ed9a7c9da4c9cd8544e6aada6e100158c142b13e019ca324cfc90982fea33994a3ed640b5c2105291a9f20028cbf44e05",
+		},
+		{
+			KMAC256,
+			"71fa95e93b9a93a48e60056ef501c9ae52d884c8a838147f73b0304c7bcd539960559d44c2b92551d13bd3cbc12a1203cd00",
+			"dd4f3601bc3239215a665808d0d5c2f04934ae7e1dbd6d0c67990cd288d4034782e3fe5a174ea807d5cdced33a54646fc9b8",
+		},
+	} {
+		if got := OneStepKMAC(tc.k, oneStepZ, oneStepSalt, oneStepFixedInfo, 50); hex.EncodeToString(got) != tc.want {
+			t.Errorf("KMAC%d: got %x, want %s", tc.k, got, tc.want)
+		}
+		if got := OneStepKMAC(tc.k, oneStepZ, nil, oneStepFixedInfo, 50); hex.EncodeToString(got) != tc.defaultSalt {
+			t.Errorf("KMAC%d with the default salt: got %x, want %s", tc.k, got, tc.defaultSalt)
+		}
+	}
+}
+
+func TestKMACKDF(t *testing.T) {
+	key := bytesRange(0, 31)
+	label, context := []byte("label"), []byte("context")
+	for _, tc := range []struct {
+		k    KMAC
+		want string
+	}{
+		{KMAC128, "47db17ba84857b444fe82174cef78469c3af5066e079615befeb779f9b6e8279b309cf6d542fae01cacc2fe9eaa2c0a0"},
+		{KMAC256, "42a9d13806b1c154f97e0c0018fb91413b8f2a70c64ed860ef7df62dd4a2517382c5117c4c5b0ac6f953656cebf8237bfa19c9b9a689b7075e4885aaf6dc98cbf668f5594d69e548edbbfb62bc685373"},
+	} {
+		if got := KMACKDF(tc.k, key, label, context, len(tc.want)/2); hex.EncodeToString(got) != tc.want {
+			t.Errorf("KMAC%d: got %x, want %s", tc.k, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package kdf
+
+import (
+	"encoding/binary"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+)
+
+// leftEncode is left_encode of NIST SP 800-185: x in big-endian with no
+// leading zeros, preceded by its length in bytes.
+func leftEncode(x uint64) []byte {
+	var b [9]byte
+	binary.BigEndian.PutUint64(b[1:], x)
+	i := 1
+	for i < 8 && b[i] == 0 {
+		i++
+	}
+	b[i-1] = byte(9 - i)
+	return b[i-1:]
+}
+
+// rightEncode is right_encode of NIST SP 800-185, like leftEncode with the
+// length after x.
+func rightEncode(x uint64) []byte {
+	var b [9]byte
+	binary.BigEndian.PutUint64(b[:8], x)
+	i := 0
+	for i < 7 && b[i] == 0 {
+		i++
+	}
+	b[8] = byte(8 - i)
+	return b[i:]
+}
+
+// encodeString is encode_string of NIST SP 800-185, s preceded by its length
+// in bits.
+func encodeString(s []byte) []byte {
+	return append(leftEncode(uint64(len(s))*8), s...)
+}
+
+// bytepad is bytepad of NIST SP 800-185, x preceded by w and padded with
+// zeros to a multiple of w bytes.
+func bytepad(x []byte, w int) []byte {
+	b := append(leftEncode(uint64(w)), x...)
+	if n := len(b) % w; n != 0 {
+		b = append(b, make([]byte, w-n)...)
+	}
+	return b
+}
+
+// rate returns the rate in bytes of the cSHAKE under k.
+func (k KMAC) rate() int {
+	switch k {
+	case KMAC128:
+		return 168
+	case KMAC256:
+		return 136
+	}
+	panic("kdf: unknown KMAC variant")
+}
+
+// sum returns KMAC(key, x, 8*length, s) of NIST SP 800-185, which is cSHAKE
+// with the function name "KMAC" on the padded key, x and the output length.
+func (k KMAC) sum(key, x []byte, length int, s []byte) []byte {
+	rate := k.rate()
+	// cSHAKE pads with 00 rather than SHAKE's 1111, hence 0x04.
+	d, err := sha3.NewSponge(rate, 0x04, 0, 24)
+	if err != nil {
+		panic(err)
+	}
+	d.Write(bytepad(append(encodeString([]byte("KMAC")), encodeString(s)...), rate))
+	d.Write(bytepad(encodeString(key), rate))
+	d.Write(x)
+	d.Write(rightEncode(uint64(length) * 8))
+	out := make([]byte, length)
+	d.Read(out)
+	return out
+}
+
+// KMACKDF returns length bytes derived from key with the KDF in KMAC mode of
+// NIST SP 800-108r1, KMAC(key, context, 8*length, label).
+func KMACKDF(k KMAC, key, label, context []byte, length int) []byte {
+	return k.sum(key, context, length, label)
+}
@@ -0,0 +1,75 @@
+package kdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+)
+
+// errOneStepLimit is returned when the 32 bit counter of the one-step KDF
+// would overflow.
+var errOneStepLimit = errors.New("kdf: one-step KDF output limit reached")
+
+// OneStep returns a Reader of the output of the one-step KDF of NIST SP
+// 800-56C Rev. 2 with h as its auxiliary function, the hash of a 32 bit
+// big-endian counter, the shared secret z and fixedInfo.
+func OneStep(h Hash, z, fixedInfo []byte) io.Reader {
+	return &oneStep{h: h.new(), z: z, fixedInfo: fixedInfo}
+}
+
+// OneStepHMAC is like OneStep with HMAC on h keyed with salt as the
+// auxiliary function. A nil salt is the default salt of zeros.
+func OneStepHMAC(h Hash, z, salt, fixedInfo []byte) io.Reader {
+	return &oneStep{h: h.hmac(salt), z: z, fixedInfo: fixedInfo}
+}
+
+// OneStepKMAC returns length bytes derived with the one-step KDF of NIST SP
+// 800-56C Rev. 2 with KMAC as its auxiliary function, which produces the
+// whole output at once: KMAC(salt, counter || z || fixedInfo, 8*length,
+// "KDF") with a counter of 1. An empty salt is the default salt, 164 zeros for
+// KMAC128 and 132 for KMAC256.
+func OneStepKMAC(k KMAC, z, salt, fixedInfo []byte, length int) []byte {
+	if len(salt) == 0 {
+		salt = make([]byte, k.rate()-4)
+	}
+	x := make([]byte, 0, 4+len(z)+len(fixedInfo))
+	x = append(x, 0, 0, 0, 1)
+	x = append(x, z...)
+	x = append(x, fixedInfo...)
+	return k.sum(salt, x, length, []byte("KDF"))
+}
+
+type oneStep struct {
+	h            hash.Hash
+	z, fixedInfo []byte
+	counter      uint32 // the number of blocks computed
+	block        []byte
+	buf          []byte // the unread end of block
+}
+
+func (f *oneStep) Read(p []byte) (int, error) {
+	// The counter mustn't go past 2^32-1, so read what is left before that,
+	// and then report it.
+	var err error
+	if left := uint64(len(f.buf)) + uint64(^uint32(0)-f.counter)*uint64(f.h.Size()); uint64(len(p)) > left {
+		p, err = p[:left], errOneStepLimit
+	}
+	n := len(p)
+	var counter [4]byte
+	for len(p) > 0 {
+		if len(f.buf) == 0 {
+			f.counter++
+			binary.BigEndian.PutUint32(counter[:], f.counter)
+			f.h.Reset()
+			f.h.Write(counter[:])
+			f.h.Write(f.z)
+			f.h.Write(f.fixedInfo)
+			f.block = f.h.Sum(f.block[:0])
+			f.buf = f.block
+		}
+		copied := copy(p, f.buf)
+		p, f.buf = p[copied:], f.buf[copied:]
+	}
+	return n, err
+}
@@ -6,8 +6,40 @@
 
 package sha3_fast
 
+import "golang.org/x/sys/cpu"
+
+// This function is implemented in keccakf_amd64.s.
+
+//go:noescape
+
+func keccakF1600AMD64(state *[25]uint64)
+
+var keccakF1600 = keccakF1600AMD64
+
 // This function is implemented in keccakf_amd64.s.
 
 //go:noescape
 
-func keccakF1600(a *[25]uint64)
+func keccakF1600AbsorbAMD64(state *[25]uint64, data *byte, blocks int, rate int)
+
+func absorbBlocksAMD64(a *[25]uint64, p []byte, rate int) {
+	keccakF1600AbsorbAMD64(a, &p[0], len(p)/rate, rate)
+}
+
+// This function is implemented in keccakf_x4_amd64.s.
+
+//go:noescape
+
+func keccakF1600x4AVX2(a *[25][4]uint64, rc *[24]uint64)
+
+func keccakF1600x4AMD64(a *[25][4]uint64) {
+	keccakF1600x4AVX2(a, &rc)
+}
+
+func init() {
+	permutations["amd64"] = keccakF1600AMD64
+	absorbBlocks = absorbBlocksAMD64
+	if cpu.X86.HasAVX2 {
+		keccakF1600x4 = keccakF1600x4AMD64
+	}
+}
@@ -45,12 +45,50 @@ var constants = [24]uint64{
 // This function is implemented in keccakf_arm.s
 func KeccakF1600(state *[25]uint64, constants *[24]uint64)
 
+// keccakF1600NEON applies the KeccakF-1600 permutation with the NEON
+// implementation, which needs the state to be 256-bit aligned. The sponge
+// state always is, but lanes passed in through KeccakF1600With may not be.
+func keccakF1600NEON(a *[25]uint64) {
+	permuteAligned(a, keccakF1600NEONAligned)
+}
+
+func keccakF1600NEONAligned(a *[25]uint64) {
+	KeccakF1600(a, &constants)
+}
+
+//go:noescape
+// This function is implemented in keccakf_arm.s
+func KeccakF1600Absorb(state *[25]uint64, constants *[24]uint64, data *byte, blocks int, rate int)
+
+// absorbBlocksNEON absorbs the blocks in p with the NEON implementation,
+// which keeps the state in NEON registers between blocks. Like
+// KeccakF1600, it needs the state to be 256-bit aligned, which the sponge
+// state always is.
+func absorbBlocksNEON(a *[25]uint64, p []byte, rate int) {
+	KeccakF1600Absorb(a, &constants, &p[0], len(p)/rate, rate)
+}
+
+// keccakF1600ARMv6 applies the KeccakF-1600 permutation to bit-interleaved
+// lanes without NEON.
+// This function is implemented in keccakf_armv6.s
+//go:noescape
+func keccakF1600ARMv6(a *[25]uint64)
+
 // If NEON is available, use the NEON implementation, otherwise fallback on
-// generic implementation
-func keccakF1600(a *[25]uint64) {
+// the ARMv6 implementation, which works on bit-interleaved lanes
+var keccakF1600 = keccakF1600Generic
+
+func init() {
+	permutations["armv6"] = onOrdinaryLanes(keccakF1600ARMv6)
+	if goarm >= 6 {
+		xorInUnaligned, copyOutUnaligned = xorInUnalignedARM, copyOutUnalignedARM
+	}
 	if goarm >= 7 {
-		KeccakF1600(a, &constants)
+		xorIn, copyOut = xorInUnaligned, copyOutUnaligned
+		keccakF1600 = keccakF1600NEON
+		absorbBlocks = absorbBlocksNEON
+		permutations["neon"] = keccakF1600NEON
 	} else {
-		keccakF1600Generic(a)
+		useInterleavedLanes(keccakF1600ARMv6)
 	}
 }
@@ -3488,3 +3488,3547 @@ TEXT ·KeccakF1600(SB), 0, $0-8
     WORD $0xf44087df;  // vst1.64  {d24}     [r0 :64]   
     WORD $0xecbd8b10;  // vpop     {d8-d15}  
     WORD $0xe12fff12;  // bx       r2        
+
+// func KeccakF1600Absorb(state *[25]uint64, constants *[24]uint64, data *byte, blocks int, rate int)
+TEXT ·KeccakF1600Absorb(SB), 0, $0-20
+    WORD $0xe59d0004;  // ldr      r0        [sp #4]   
+    WORD $0xe59d1008;  // ldr      r1        [sp #8]   
+    WORD $0xe59d300c;  // ldr      r3        [sp #12]  
+    WORD $0xe59dc010;  // ldr      r12       [sp #16]  
+    WORD $0xe59d4014;  // ldr      r4        [sp #20]  
+    WORD $0xe1a0200e;  // mov      r2        lr  
+    WORD $0xed2d8b10;  // vpush    {d8-d15}  
+    WORD $0xf42007dd;  // vld1.64  {d0}      [r0 :64]!  
+    WORD $0xf42027dd;  // vld1.64  {d2}      [r0 :64]!  
+    WORD $0xf42047dd;  // vld1.64  {d4}      [r0 :64]!  
+    WORD $0xf42067dd;  // vld1.64  {d6}      [r0 :64]!  
+    WORD $0xf42087dd;  // vld1.64  {d8}      [r0 :64]!  
+    WORD $0xf42017dd;  // vld1.64  {d1}      [r0 :64]!  
+    WORD $0xf42037dd;  // vld1.64  {d3}      [r0 :64]!  
+    WORD $0xf42057dd;  // vld1.64  {d5}      [r0 :64]!  
+    WORD $0xf42077dd;  // vld1.64  {d7}      [r0 :64]!  
+    WORD $0xf42097dd;  // vld1.64  {d9}      [r0 :64]!  
+    WORD $0xf420a7dd;  // vld1.64  {d10}     [r0 :64]!  
+    WORD $0xf420c7dd;  // vld1.64  {d12}     [r0 :64]!  
+    WORD $0xf420e7dd;  // vld1.64  {d14}     [r0 :64]!  
+    WORD $0xf46007dd;  // vld1.64  {d16}     [r0 :64]!  
+    WORD $0xf46027dd;  // vld1.64  {d18}     [r0 :64]!  
+    WORD $0xf420b7dd;  // vld1.64  {d11}     [r0 :64]!  
+    WORD $0xf420d7dd;  // vld1.64  {d13}     [r0 :64]!  
+    WORD $0xf420f7dd;  // vld1.64  {d15}     [r0 :64]!  
+    WORD $0xf46017dd;  // vld1.64  {d17}     [r0 :64]!  
+    WORD $0xf46037dd;  // vld1.64  {d19}     [r0 :64]!  
+    WORD $0xf4604aed;  // vld1.64  {d20-d21} [r0 :128]! 
+    WORD $0xf4606aed;  // vld1.64  {d22-d23} [r0 :128]! 
+    WORD $0xf46087df;  // vld1.64  {d24}     [r0 :64]   
+    WORD $0xe24000c0;  // sub      r0        r0         #192 
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3000139;  // veor     d0        d0        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3022139;  // veor     d2        d2        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3044139;  // veor     d4        d4        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3066139;  // veor     d6        d6        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3088139;  // veor     d8        d8        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3011139;  // veor     d1        d1        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3033139;  // veor     d3        d3        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3055139;  // veor     d5        d5        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3077139;  // veor     d7        d7        d25       
+    WORD $0xe3540068;  // cmp      r4        #104      
+    WORD $0xba00001d;  // blt      KeccakF1600Absorb_permute 
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf3099139;  // veor     d9        d9        d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf30aa139;  // veor     d10       d10       d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf30cc139;  // veor     d12       d12       d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf30ee139;  // veor     d14       d14       d25       
+    WORD $0xe3540088;  // cmp      r4        #136      
+    WORD $0xba000013;  // blt      KeccakF1600Absorb_permute 
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf34001b9;  // veor     d16       d16       d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf34221b9;  // veor     d18       d18       d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf30bb139;  // veor     d11       d11       d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf30dd139;  // veor     d13       d13       d25       
+    WORD $0xe3540090;  // cmp      r4        #144      
+    WORD $0xba000009;  // blt      KeccakF1600Absorb_permute 
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf30ff139;  // veor     d15       d15       d25       
+    WORD $0xe35400a8;  // cmp      r4        #168      
+    WORD $0xba000005;  // blt      KeccakF1600Absorb_permute 
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf34111b9;  // veor     d17       d17       d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf34331b9;  // veor     d19       d19       d25       
+    WORD $0xf463970d;  // vld1.8   {d25}     [r3]!     
+    WORD $0xf34441b9;  // veor     d20       d20       d25       
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xf340a15a;  // veor     q13       q0         q5   
+    WORD $0xf4408aed;  // vst1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf342c15c;  // veor     q14       q1         q6 
+    WORD $0xf4008aed;  // vst1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34aa1bb;  // veor     d26       d26        d27 
+    WORD $0xf4402aef;  // vst1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34aa1b4;  // veor     d26       d26        d20 
+    WORD $0xf34cb1b5;  // veor     d27       d28        d21 
+    WORD $0xf344c15e;  // veor     q14       q2         q7  
+    WORD $0xf346e170;  // veor     q15       q3         q8  
+    WORD $0xf3088172;  // veor     q4        q4         q9  
+    WORD $0xf34cc1bd;  // veor     d28       d28        d29 
+    WORD $0xf34ee1bf;  // veor     d30       d30        d31 
+    WORD $0xf3489119;  // veor     d25       d8         d9  
+    WORD $0xf34cc1b6;  // veor     d28       d28        d22 
+    WORD $0xf34ed1b7;  // veor     d29       d30        d23 
+    WORD $0xf34991b8;  // veor     d25       d25        d24 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf27be8ab;  // vadd.i64 d30       d27        d27 
+    WORD $0xf27c88ac;  // vadd.i64 d24       d28        d28 
+    WORD $0xf23d88ad;  // vadd.i64 d8        d29        d29 
+    WORD $0xf27928a9;  // vadd.i64 d18       d25        d25 
+    WORD $0xf3c1e4bb;  // vsri.64  d30       d27        #63 
+    WORD $0xf3c184bc;  // vsri.64  d24       d28        #63 
+    WORD $0xf38184bd;  // vsri.64  d8        d29        #63 
+    WORD $0xf3c124b9;  // vsri.64  d18       d25        #63 
+    WORD $0xf34ee1b9;  // veor     d30       d30        d25 
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf308813b;  // veor     d8        d8         d27 
+    WORD $0xf27ab8aa;  // vadd.i64 d27       d26        d26 
+    WORD $0xf34221bc;  // veor     d18       d18        d28 
+    WORD $0xf26ef1be;  // vorr     d31       d30        d30 
+    WORD $0xf26891b8;  // vorr     d25       d24        d24 
+    WORD $0xf3c1b4ba;  // vsri.64  d27       d26        #63 
+    WORD $0xf2289118;  // vorr     d9        d8         d8  
+    WORD $0xf26231b2;  // vorr     d19       d18        d18 
+    WORD $0xf34441be;  // veor     d20       d20        d30 
+    WORD $0xf34551b8;  // veor     d21       d21        d24 
+    WORD $0xf34bb1bd;  // veor     d27       d27        d29 
+    WORD $0xf3466198;  // veor     d22       d22        d8  
+    WORD $0xf34771b2;  // veor     d23       d23        d18 
+    WORD $0xf26ba1bb;  // vorr     d26       d27        d27 
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf3022178;  // veor     q1        q1         q12 
+    WORD $0xf3044158;  // veor     q2        q2         q4  
+    WORD $0xf3066172;  // veor     q3        q3         q9  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf30cc178;  // veor     q6        q6         q12 
+    WORD $0xf4608aed;  // vld1.64  {d24-d25} [r0 :128]! 
+    WORD $0xf30ee158;  // veor     q7        q7         q4 
+    WORD $0xf4208aed;  // vld1.64  {d8-d9}   [r0 :128]! 
+    WORD $0xf34001f2;  // veor     q8        q8         q9 
+    WORD $0xf4602aef;  // vld1.64  {d18-d19} [r0 :128]  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xe2400020;  // sub      r0        r0         #32 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf262b112;  // vorr     d27       d2         d2  
+    WORD $0xf264c114;  // vorr     d28       d4         d4  
+    WORD $0xf266d116;  // vorr     d29       d6         d6  
+    WORD $0xf2689118;  // vorr     d25       d8         d8  
+    WORD $0xf2ac2593;  // vshl.s64 d2        d3         #44 
+    WORD $0xf2ab459e;  // vshl.s64 d4        d14        #43 
+    WORD $0xf28e85b8;  // vshl.s64 d8        d24        #14 
+    WORD $0xf29565b1;  // vshl.s64 d6        d17        #21 
+    WORD $0xf3ac2493;  // vsri.64  d2        d3         #20 
+    WORD $0xf3ab449e;  // vsri.64  d4        d14        #21 
+    WORD $0xf38e84b8;  // vsri.64  d8        d24        #50 
+    WORD $0xf39564b1;  // vsri.64  d6        d17        #43 
+    WORD $0xf2943599;  // vshl.s64 d3        d9         #20 
+    WORD $0xf299e5b0;  // vshl.s64 d14       d16        #25 
+    WORD $0xf2c285b5;  // vshl.s64 d24       d21        #2  
+    WORD $0xf2cf159f;  // vshl.s64 d17       d15        #15 
+    WORD $0xf3943499;  // vsri.64  d3        d9         #44 
+    WORD $0xf399e4b0;  // vsri.64  d14       d16        #39 
+    WORD $0xf3c284b5;  // vsri.64  d24       d21        #62 
+    WORD $0xf3cf149f;  // vsri.64  d17       d15        #49 
+    WORD $0xf2bd95b6;  // vshl.s64 d9        d22        #61 
+    WORD $0xf2f307a3;  // vext.8   d16       d19        d19 #7 
+    WORD $0xf2f75597;  // vshl.s64 d21       d7         #55 
+    WORD $0xf28af59c;  // vshl.s64 d15       d12        #10 
+    WORD $0xf3bd94b6;  // vsri.64  d9        d22        #3  
+    WORD $0xf3f75497;  // vsri.64  d21       d7         #9  
+    WORD $0xf38af49c;  // vsri.64  d15       d12        #54 
+    WORD $0xf2e765b2;  // vshl.s64 d22       d18        #39 
+    WORD $0xf2f731a7;  // vext.8   d19       d23        d23 #1 
+    WORD $0xf2ad759d;  // vshl.s64 d7        d13        #45 
+    WORD $0xf286c595;  // vshl.s64 d12       d5         #6  
+    WORD $0xf3e764b2;  // vsri.64  d22       d18        #25 
+    WORD $0xf3ad749d;  // vsri.64  d7        d13        #19 
+    WORD $0xf386c495;  // vsri.64  d12       d5         #58 
+    WORD $0xf2d225b4;  // vshl.s64 d18       d20        #18 
+    WORD $0xf2e9759b;  // vshl.s64 d23       d11        #41 
+    WORD $0xf2a4d591;  // vshl.s64 d13       d1         #36 
+    WORD $0xf283559a;  // vshl.s64 d5        d10        #3  
+    WORD $0xf3d224b4;  // vsri.64  d18       d20        #46 
+    WORD $0xf3e9749b;  // vsri.64  d23       d11        #23 
+    WORD $0xf3a4d491;  // vsri.64  d13       d1         #28 
+    WORD $0xf383549a;  // vsri.64  d5        d10        #61 
+    WORD $0xf2fe45bc;  // vshl.s64 d20       d28        #62 
+    WORD $0xf29bb5b9;  // vshl.s64 d11       d25        #27 
+    WORD $0xf29c15bd;  // vshl.s64 d1        d29        #28 
+    WORD $0xf281a5bb;  // vshl.s64 d10       d27        #1  
+    WORD $0xf3fe44bc;  // vsri.64  d20       d28        #2  
+    WORD $0xf39bb4b9;  // vsri.64  d11       d25        #37 
+    WORD $0xf39c14bd;  // vsri.64  d1        d29        #36 
+    WORD $0xf381a4bb;  // vsri.64  d10       d27        #63 
+    WORD $0xf260a150;  // vorr     q13       q0         q0  
+    WORD $0xf254e152;  // vbic     q15       q2         q1  
+    WORD $0xf300017e;  // veor     q0        q0         q15 
+    WORD $0xf262c152;  // vorr     q14       q1         q1  
+    WORD $0xf256e154;  // vbic     q15       q3         q2  
+    WORD $0xf302217e;  // veor     q1        q1         q15 
+    WORD $0xf258e156;  // vbic     q15       q4         q3  
+    WORD $0xf304417e;  // veor     q2        q2         q15 
+    WORD $0xf25ae1d8;  // vbic     q15       q13        q4  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf306617e;  // veor     q3        q3         q15 
+    WORD $0xf308817a;  // veor     q4        q4         q13 
+    WORD $0xf26aa15a;  // vorr     q13       q5         q5  
+    WORD $0xf25ee15c;  // vbic     q15       q7         q6  
+    WORD $0xf30aa17e;  // veor     q5        q5         q15 
+    WORD $0xf26cc15c;  // vorr     q14       q6         q6  
+    WORD $0xf250e1de;  // vbic     q15       q8         q7  
+    WORD $0xf30cc17e;  // veor     q6        q6         q15 
+    WORD $0xf252e1f0;  // vbic     q15       q9         q8  
+    WORD $0xf30ee17e;  // veor     q7        q7         q15 
+    WORD $0xf25ae1f2;  // vbic     q15       q13        q9  
+    WORD $0xf25ca1fa;  // vbic     q13       q14        q13 
+    WORD $0xf34001fe;  // veor     q8        q8         q15 
+    WORD $0xf34221fa;  // veor     q9        q9         q13 
+    WORD $0xf264a1f4;  // vorr     q13       q10        q10 
+    WORD $0xf256e1b5;  // vbic     d30       d22        d21 
+    WORD $0xf257f1b6;  // vbic     d31       d23        d22 
+    WORD $0xf34441fe;  // veor     q10       q10        q15 
+    WORD $0xf258e1b7;  // vbic     d30       d24        d23 
+    WORD $0xf25af1b8;  // vbic     d31       d26        d24 
+    WORD $0xf25ba1ba;  // vbic     d26       d27        d26 
+    WORD $0xf34661fe;  // veor     q11       q11        q15 
+    WORD $0xf461e7dd;  // vld1.64  {d30}     [r1 :64]!  
+    WORD $0xf34881ba;  // veor     d24       d24        d26 
+    WORD $0xf300013e;  // veor     d0        d0         d30 
+    WORD $0xe24110c0;  // sub      r1        r1        #192      
+    WORD $0xe25cc001;  // subs     r12       r12       #1        
+    WORD $0x1afff262;  // bne      KeccakF1600Absorb_loop 
+    WORD $0xf40007dd;  // vst1.64  {d0}      [r0 :64]!  
+    WORD $0xf40027dd;  // vst1.64  {d2}      [r0 :64]!  
+    WORD $0xf40047dd;  // vst1.64  {d4}      [r0 :64]!  
+    WORD $0xf40067dd;  // vst1.64  {d6}      [r0 :64]!  
+    WORD $0xf40087dd;  // vst1.64  {d8}      [r0 :64]!  
+    WORD $0xf40017dd;  // vst1.64  {d1}      [r0 :64]!  
+    WORD $0xf40037dd;  // vst1.64  {d3}      [r0 :64]!  
+    WORD $0xf40057dd;  // vst1.64  {d5}      [r0 :64]!  
+    WORD $0xf40077dd;  // vst1.64  {d7}      [r0 :64]!  
+    WORD $0xf40097dd;  // vst1.64  {d9}      [r0 :64]!  
+    WORD $0xf400a7dd;  // vst1.64  {d10}     [r0 :64]!  
+    WORD $0xf400c7dd;  // vst1.64  {d12}     [r0 :64]!  
+    WORD $0xf400e7dd;  // vst1.64  {d14}     [r0 :64]!  
+    WORD $0xf44007dd;  // vst1.64  {d16}     [r0 :64]!  
+    WORD $0xf44027dd;  // vst1.64  {d18}     [r0 :64]!  
+    WORD $0xf400b7dd;  // vst1.64  {d11}     [r0 :64]!  
+    WORD $0xf400d7dd;  // vst1.64  {d13}     [r0 :64]!  
+    WORD $0xf400f7dd;  // vst1.64  {d15}     [r0 :64]!  
+    WORD $0xf44017dd;  // vst1.64  {d17}     [r0 :64]!  
+    WORD $0xf44037dd;  // vst1.64  {d19}     [r0 :64]!  
+    WORD $0xf4404aed;  // vst1.64  {d20-d21} [r0 :128]! 
+    WORD $0xf4406aed;  // vst1.64  {d22-d23} [r0 :128]! 
+    WORD $0xf44087df;  // vst1.64  {d24}     [r0 :64]   
+    WORD $0xecbd8b10;  // vpop     {d8-d15}  
+    WORD $0xe12fff12;  // bx       r2        
@@ -0,0 +1,572 @@
+// +build arm,!appengine,!gccgo
+
+#include "textflag.h"
+
+// A scalar implementation of the KeccakF-1600 permutation for ARMv6 (and
+// later) cores without NEON, such as the Raspberry Pi Zero and Pi 1. It works
+// on the bit-interleaved lanes used by keccakF1600Interleaved, so that every
+// rotation is a 32-bit rotation which the barrel shifter applies for free to
+// the operand of a MOVW or EOR.
+//
+// Lane complementing isn't used, as its purpose is to remove the NOTs from
+// the χ step, and ARM already computes ^b & c in one instruction with BIC.
+//
+// The state is read from and written back to memory in every step, with the
+// θ effect D and the output of the π step B kept in the frame :
+//
+//	4(R13)   - 203(R13) B, 25 lanes of even and odd words
+//	204(R13) - 243(R13) D, 5 lanes of even and odd words
+//	244(R13)            pointer to the round constants for this round
+//
+// R12 holds the state, and R14 is used as a temporary, which is safe as the
+// prologue saves the link register.
+
+// func keccakF1600ARMv6(a *[25]uint64)
+TEXT ·keccakF1600ARMv6(SB), NOSPLIT, $248-4
+	MOVW	a+0(FP), R12
+	MOVW	$·roundConstantsInterleaved(SB), R0
+	MOVW	R0, 244(R13)
+
+loop:
+	// θ step, the column parities are kept in R0-R9 with the even and odd
+	// words of column x in R(2x) and R(2x+1)
+	MOVW	0(R12), R0
+	MOVW	40(R12), R14
+	EOR	R14, R0
+	MOVW	80(R12), R14
+	EOR	R14, R0
+	MOVW	120(R12), R14
+	EOR	R14, R0
+	MOVW	160(R12), R14
+	EOR	R14, R0
+	MOVW	4(R12), R1
+	MOVW	44(R12), R14
+	EOR	R14, R1
+	MOVW	84(R12), R14
+	EOR	R14, R1
+	MOVW	124(R12), R14
+	EOR	R14, R1
+	MOVW	164(R12), R14
+	EOR	R14, R1
+	MOVW	8(R12), R2
+	MOVW	48(R12), R14
+	EOR	R14, R2
+	MOVW	88(R12), R14
+	EOR	R14, R2
+	MOVW	128(R12), R14
+	EOR	R14, R2
+	MOVW	168(R12), R14
+	EOR	R14, R2
+	MOVW	12(R12), R3
+	MOVW	52(R12), R14
+	EOR	R14, R3
+	MOVW	92(R12), R14
+	EOR	R14, R3
+	MOVW	132(R12), R14
+	EOR	R14, R3
+	MOVW	172(R12), R14
+	EOR	R14, R3
+	MOVW	16(R12), R4
+	MOVW	56(R12), R14
+	EOR	R14, R4
+	MOVW	96(R12), R14
+	EOR	R14, R4
+	MOVW	136(R12), R14
+	EOR	R14, R4
+	MOVW	176(R12), R14
+	EOR	R14, R4
+	MOVW	20(R12), R5
+	MOVW	60(R12), R14
+	EOR	R14, R5
+	MOVW	100(R12), R14
+	EOR	R14, R5
+	MOVW	140(R12), R14
+	EOR	R14, R5
+	MOVW	180(R12), R14
+	EOR	R14, R5
+	MOVW	24(R12), R6
+	MOVW	64(R12), R14
+	EOR	R14, R6
+	MOVW	104(R12), R14
+	EOR	R14, R6
+	MOVW	144(R12), R14
+	EOR	R14, R6
+	MOVW	184(R12), R14
+	EOR	R14, R6
+	MOVW	28(R12), R7
+	MOVW	68(R12), R14
+	EOR	R14, R7
+	MOVW	108(R12), R14
+	EOR	R14, R7
+	MOVW	148(R12), R14
+	EOR	R14, R7
+	MOVW	188(R12), R14
+	EOR	R14, R7
+	MOVW	32(R12), R8
+	MOVW	72(R12), R14
+	EOR	R14, R8
+	MOVW	112(R12), R14
+	EOR	R14, R8
+	MOVW	152(R12), R14
+	EOR	R14, R8
+	MOVW	192(R12), R14
+	EOR	R14, R8
+	MOVW	36(R12), R9
+	MOVW	76(R12), R14
+	EOR	R14, R9
+	MOVW	116(R12), R14
+	EOR	R14, R9
+	MOVW	156(R12), R14
+	EOR	R14, R9
+	MOVW	196(R12), R14
+	EOR	R14, R9
+
+	// D[x] = C[x-1] ^ rot(C[x+1], 1), where rotating by one swaps the even
+	// and odd words and rotates the new even word by one
+	EOR	R3@>31, R8, R14
+	MOVW	R14, 204(R13)
+	EOR	R2, R9, R14
+	MOVW	R14, 208(R13)
+	EOR	R5@>31, R0, R14
+	MOVW	R14, 212(R13)
+	EOR	R4, R1, R14
+	MOVW	R14, 216(R13)
+	EOR	R7@>31, R2, R14
+	MOVW	R14, 220(R13)
+	EOR	R6, R3, R14
+	MOVW	R14, 224(R13)
+	EOR	R9@>31, R4, R14
+	MOVW	R14, 228(R13)
+	EOR	R8, R5, R14
+	MOVW	R14, 232(R13)
+	EOR	R1@>31, R6, R14
+	MOVW	R14, 236(R13)
+	EOR	R0, R7, R14
+	MOVW	R14, 240(R13)
+
+	// ρ and π steps, B[y, 2x+3y] = rot(A[x, y] ^ D[x], r[x, y]), with odd
+	// rotations swapping the even and odd words
+	MOVW	204(R13), R0
+	MOVW	208(R13), R1
+	MOVW	0(R12), R2
+	MOVW	4(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2, 4(R13)
+	MOVW	R3, 8(R13)
+	MOVW	40(R12), R2
+	MOVW	44(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>14, R2
+	MOVW	R3@>14, R3
+	MOVW	R2, 132(R13)
+	MOVW	R3, 136(R13)
+	MOVW	80(R12), R2
+	MOVW	84(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>30, R3
+	MOVW	R2@>31, R2
+	MOVW	R3, 60(R13)
+	MOVW	R2, 64(R13)
+	MOVW	120(R12), R2
+	MOVW	124(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>11, R3
+	MOVW	R2@>12, R2
+	MOVW	R3, 188(R13)
+	MOVW	R2, 192(R13)
+	MOVW	160(R12), R2
+	MOVW	164(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>23, R2
+	MOVW	R3@>23, R3
+	MOVW	R2, 116(R13)
+	MOVW	R3, 120(R13)
+	MOVW	212(R13), R0
+	MOVW	216(R13), R1
+	MOVW	8(R12), R2
+	MOVW	12(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>31, R3
+	MOVW	R3, 84(R13)
+	MOVW	R2, 88(R13)
+	MOVW	48(R12), R2
+	MOVW	52(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>10, R2
+	MOVW	R3@>10, R3
+	MOVW	R2, 12(R13)
+	MOVW	R3, 16(R13)
+	MOVW	88(R12), R2
+	MOVW	92(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>27, R2
+	MOVW	R3@>27, R3
+	MOVW	R2, 140(R13)
+	MOVW	R3, 144(R13)
+	MOVW	128(R12), R2
+	MOVW	132(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>9, R3
+	MOVW	R2@>10, R2
+	MOVW	R3, 68(R13)
+	MOVW	R2, 72(R13)
+	MOVW	168(R12), R2
+	MOVW	172(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>31, R2
+	MOVW	R3@>31, R3
+	MOVW	R2, 196(R13)
+	MOVW	R3, 200(R13)
+	MOVW	220(R13), R0
+	MOVW	224(R13), R1
+	MOVW	16(R12), R2
+	MOVW	20(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>1, R2
+	MOVW	R3@>1, R3
+	MOVW	R2, 164(R13)
+	MOVW	R3, 168(R13)
+	MOVW	56(R12), R2
+	MOVW	60(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>29, R2
+	MOVW	R3@>29, R3
+	MOVW	R2, 92(R13)
+	MOVW	R3, 96(R13)
+	MOVW	96(R12), R2
+	MOVW	100(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>10, R3
+	MOVW	R2@>11, R2
+	MOVW	R3, 20(R13)
+	MOVW	R2, 24(R13)
+	MOVW	136(R12), R2
+	MOVW	140(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>24, R3
+	MOVW	R2@>25, R2
+	MOVW	R3, 148(R13)
+	MOVW	R2, 152(R13)
+	MOVW	176(R12), R2
+	MOVW	180(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>1, R3
+	MOVW	R2@>2, R2
+	MOVW	R3, 76(R13)
+	MOVW	R2, 80(R13)
+	MOVW	228(R13), R0
+	MOVW	232(R13), R1
+	MOVW	24(R12), R2
+	MOVW	28(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>18, R2
+	MOVW	R3@>18, R3
+	MOVW	R2, 44(R13)
+	MOVW	R3, 48(R13)
+	MOVW	64(R12), R2
+	MOVW	68(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>4, R3
+	MOVW	R2@>5, R2
+	MOVW	R3, 172(R13)
+	MOVW	R2, 176(R13)
+	MOVW	104(R12), R2
+	MOVW	108(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>19, R3
+	MOVW	R2@>20, R2
+	MOVW	R3, 100(R13)
+	MOVW	R2, 104(R13)
+	MOVW	144(R12), R2
+	MOVW	148(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>21, R3
+	MOVW	R2@>22, R2
+	MOVW	R3, 28(R13)
+	MOVW	R2, 32(R13)
+	MOVW	184(R12), R2
+	MOVW	188(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>4, R2
+	MOVW	R3@>4, R3
+	MOVW	R2, 156(R13)
+	MOVW	R3, 160(R13)
+	MOVW	236(R13), R0
+	MOVW	240(R13), R1
+	MOVW	32(R12), R2
+	MOVW	36(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>18, R3
+	MOVW	R2@>19, R2
+	MOVW	R3, 124(R13)
+	MOVW	R2, 128(R13)
+	MOVW	72(R12), R2
+	MOVW	76(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>22, R2
+	MOVW	R3@>22, R3
+	MOVW	R2, 52(R13)
+	MOVW	R3, 56(R13)
+	MOVW	112(R12), R2
+	MOVW	116(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R3@>12, R3
+	MOVW	R2@>13, R2
+	MOVW	R3, 180(R13)
+	MOVW	R2, 184(R13)
+	MOVW	152(R12), R2
+	MOVW	156(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>28, R2
+	MOVW	R3@>28, R3
+	MOVW	R2, 108(R13)
+	MOVW	R3, 112(R13)
+	MOVW	192(R12), R2
+	MOVW	196(R12), R3
+	EOR	R0, R2
+	EOR	R1, R3
+	MOVW	R2@>25, R2
+	MOVW	R3@>25, R3
+	MOVW	R2, 36(R13)
+	MOVW	R3, 40(R13)
+
+	// χ and ι steps, a plane of even or odd words at a time, using BIC for
+	// the ^b & c terms
+	MOVW	4(R13), R0
+	MOVW	12(R13), R1
+	MOVW	20(R13), R2
+	MOVW	28(R13), R3
+	MOVW	36(R13), R4
+	MOVW	244(R13), R6
+	MOVW	0(R6), R6
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	EOR	R6, R5
+	MOVW	R5, 0(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 8(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 16(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 24(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 32(R12)
+	MOVW	8(R13), R0
+	MOVW	16(R13), R1
+	MOVW	24(R13), R2
+	MOVW	32(R13), R3
+	MOVW	40(R13), R4
+	MOVW	244(R13), R6
+	MOVW	4(R6), R6
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	EOR	R6, R5
+	MOVW	R5, 4(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 12(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 20(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 28(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 36(R12)
+	MOVW	44(R13), R0
+	MOVW	52(R13), R1
+	MOVW	60(R13), R2
+	MOVW	68(R13), R3
+	MOVW	76(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 40(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 48(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 56(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 64(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 72(R12)
+	MOVW	48(R13), R0
+	MOVW	56(R13), R1
+	MOVW	64(R13), R2
+	MOVW	72(R13), R3
+	MOVW	80(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 44(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 52(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 60(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 68(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 76(R12)
+	MOVW	84(R13), R0
+	MOVW	92(R13), R1
+	MOVW	100(R13), R2
+	MOVW	108(R13), R3
+	MOVW	116(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 80(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 88(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 96(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 104(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 112(R12)
+	MOVW	88(R13), R0
+	MOVW	96(R13), R1
+	MOVW	104(R13), R2
+	MOVW	112(R13), R3
+	MOVW	120(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 84(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 92(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 100(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 108(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 116(R12)
+	MOVW	124(R13), R0
+	MOVW	132(R13), R1
+	MOVW	140(R13), R2
+	MOVW	148(R13), R3
+	MOVW	156(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 120(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 128(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 136(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 144(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 152(R12)
+	MOVW	128(R13), R0
+	MOVW	136(R13), R1
+	MOVW	144(R13), R2
+	MOVW	152(R13), R3
+	MOVW	160(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 124(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 132(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 140(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 148(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 156(R12)
+	MOVW	164(R13), R0
+	MOVW	172(R13), R1
+	MOVW	180(R13), R2
+	MOVW	188(R13), R3
+	MOVW	196(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 160(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 168(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 176(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 184(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 192(R12)
+	MOVW	168(R13), R0
+	MOVW	176(R13), R1
+	MOVW	184(R13), R2
+	MOVW	192(R13), R3
+	MOVW	200(R13), R4
+	BIC	R1, R2, R5
+	EOR	R0, R5
+	MOVW	R5, 164(R12)
+	BIC	R2, R3, R5
+	EOR	R1, R5
+	MOVW	R5, 172(R12)
+	BIC	R3, R4, R5
+	EOR	R2, R5
+	MOVW	R5, 180(R12)
+	BIC	R4, R0, R5
+	EOR	R3, R5
+	MOVW	R5, 188(R12)
+	BIC	R0, R1, R5
+	EOR	R4, R5
+	MOVW	R5, 196(R12)
+
+	MOVW	244(R13), R0
+	ADD	$8, R0
+	MOVW	R0, 244(R13)
+	MOVW	$·roundConstantsInterleaved+192(SB), R1
+	CMP	R1, R0
+	BNE	loop
+	RET
@@ -0,0 +1,356 @@
+package sha3_fast
+
+// A KeccakF-1600 implementation for 32-bit platforms that keeps each lane in
+// bit-interleaved form, as described in section 2.1 of "Keccak implementation
+// overview" by the Keccak team. The even bits of a lane are held in one
+// 32-bit word and the odd bits in another, so that every 64-bit rotation
+// becomes a pair of 32-bit rotations rather than four shifts and two ors.
+//
+// The lanes are stored in the uint64s of the state with the even word in the
+// low 32 bits and the odd word in the high 32 bits. Converting to and from
+// that form is done in xorInInterleaved and copyOutInterleaved, so it only
+// happens once per block rather than once per round.
+
+import "encoding/binary"
+
+// roundConstantsInterleaved holds the round constants for the iota step as
+// pairs of even and odd words.
+var roundConstantsInterleaved = [24][2]uint32{
+	{0x00000001, 0x00000000},
+	{0x00000000, 0x00000089},
+	{0x00000000, 0x8000008b},
+	{0x00000000, 0x80008080},
+	{0x00000001, 0x0000008b},
+	{0x00000001, 0x00008000},
+	{0x00000001, 0x80008088},
+	{0x00000001, 0x80000082},
+	{0x00000000, 0x0000000b},
+	{0x00000000, 0x0000000a},
+	{0x00000001, 0x00008082},
+	{0x00000000, 0x00008003},
+	{0x00000001, 0x0000808b},
+	{0x00000001, 0x8000000b},
+	{0x00000001, 0x8000008a},
+	{0x00000001, 0x80000081},
+	{0x00000000, 0x80000081},
+	{0x00000000, 0x80000008},
+	{0x00000000, 0x00000083},
+	{0x00000000, 0x80008003},
+	{0x00000001, 0x80008088},
+	{0x00000000, 0x80000088},
+	{0x00000001, 0x00008000},
+	{0x00000000, 0x80008082}}
+
+// interleave splits the 64-bit lane with the low word lo and the high word
+// hi into its even and odd bits.
+func interleave(lo, hi uint32) (even, odd uint32) {
+	lo = unshuffle32(lo)
+	hi = unshuffle32(hi)
+	return lo&0xffff | hi<<16, lo>>16 | hi&0xffff0000
+}
+
+// deinterleave is the inverse of interleave.
+func deinterleave(even, odd uint32) (lo, hi uint32) {
+	lo = even&0xffff | odd<<16
+	hi = even>>16 | odd&0xffff0000
+	return shuffle32(lo), shuffle32(hi)
+}
+
+// unshuffle32 moves the even bits of x to its low half and the odd bits to
+// its high half, keeping them in order.
+func unshuffle32(x uint32) uint32 {
+	t := (x ^ x>>1) & 0x22222222
+	x ^= t ^ t<<1
+	t = (x ^ x>>2) & 0x0c0c0c0c
+	x ^= t ^ t<<2
+	t = (x ^ x>>4) & 0x00f000f0
+	x ^= t ^ t<<4
+	t = (x ^ x>>8) & 0x0000ff00
+	x ^= t ^ t<<8
+	return x
+}
+
+// shuffle32 is the inverse of unshuffle32.
+func shuffle32(x uint32) uint32 {
+	t := (x ^ x>>8) & 0x0000ff00
+	x ^= t ^ t<<8
+	t = (x ^ x>>4) & 0x00f000f0
+	x ^= t ^ t<<4
+	t = (x ^ x>>2) & 0x0c0c0c0c
+	x ^= t ^ t<<2
+	t = (x ^ x>>1) & 0x22222222
+	x ^= t ^ t<<1
+	return x
+}
+
+// xorInInterleaved xors the bytes in buf into the bit-interleaved state.
+func xorInInterleaved(d *state, buf []byte) {
+	n := len(buf) / 8
+
+	for i := 0; i < n; i++ {
+		even, odd := interleave(binary.LittleEndian.Uint32(buf), binary.LittleEndian.Uint32(buf[4:]))
+		d.a[i] ^= uint64(odd)<<32 | uint64(even)
+		buf = buf[8:]
+	}
+}
+
+// copyOutInterleaved copies the bit-interleaved state to a byte buffer.
+func copyOutInterleaved(d *state, b []byte) {
+	for i := 0; len(b) >= 8; i++ {
+		lo, hi := deinterleave(uint32(d.a[i]), uint32(d.a[i]>>32))
+		binary.LittleEndian.PutUint32(b, lo)
+		binary.LittleEndian.PutUint32(b[4:], hi)
+		b = b[8:]
+	}
+}
+
+// interleavedLanes is set when the sponge keeps its state in bit-interleaved
+// form, which means that xorIn, copyOut and keccakF1600 all have to be the
+// interleaved implementations.
+var interleavedLanes bool
+
+// useInterleavedLanes switches the sponge to the bit-interleaved form with
+// the permutation f, which must work on bit-interleaved lanes. It is called
+// at init time on 32-bit platforms that have no faster permutation.
+func useInterleavedLanes(f func(a *[25]uint64)) {
+	xorIn, copyOut, keccakF1600 = xorInInterleaved, copyOutInterleaved, f
+	interleavedLanes = true
+}
+
+// onOrdinaryLanes returns a permutation that applies the bit-interleaved
+// permutation f to ordinary lanes, converting them before and after. It is
+// only meant for cross-checking against the other implementations.
+func onOrdinaryLanes(f func(a *[25]uint64)) func(a *[25]uint64) {
+	return func(a *[25]uint64) {
+		for i, lane := range a {
+			even, odd := interleave(uint32(lane), uint32(lane>>32))
+			a[i] = uint64(odd)<<32 | uint64(even)
+		}
+		f(a)
+		for i, lane := range a {
+			lo, hi := deinterleave(uint32(lane), uint32(lane>>32))
+			a[i] = uint64(hi)<<32 | uint64(lo)
+		}
+	}
+}
+
+// keccakF1600Interleaved applies the KeccakF-1600 permutation to a state of
+// bit-interleaved lanes.
+func keccakF1600Interleaved(a *[25]uint64) {
+	keccakP1600Interleaved(a, 24)
+}
+
+// keccakP1600Interleaved applies the last rounds rounds of the KeccakF-1600
+// permutation to a state of bit-interleaved lanes. The lanes are named after
+// their index in the state, with e and o prefixes for their even and odd
+// words, and be and bo for the words after the ρ and π steps.
+func keccakP1600Interleaved(a *[25]uint64, rounds int) {
+	e00, o00 := uint32(a[0]), uint32(a[0]>>32)
+	e01, o01 := uint32(a[1]), uint32(a[1]>>32)
+	e02, o02 := uint32(a[2]), uint32(a[2]>>32)
+	e03, o03 := uint32(a[3]), uint32(a[3]>>32)
+	e04, o04 := uint32(a[4]), uint32(a[4]>>32)
+	e05, o05 := uint32(a[5]), uint32(a[5]>>32)
+	e06, o06 := uint32(a[6]), uint32(a[6]>>32)
+	e07, o07 := uint32(a[7]), uint32(a[7]>>32)
+	e08, o08 := uint32(a[8]), uint32(a[8]>>32)
+	e09, o09 := uint32(a[9]), uint32(a[9]>>32)
+	e10, o10 := uint32(a[10]), uint32(a[10]>>32)
+	e11, o11 := uint32(a[11]), uint32(a[11]>>32)
+	e12, o12 := uint32(a[12]), uint32(a[12]>>32)
+	e13, o13 := uint32(a[13]), uint32(a[13]>>32)
+	e14, o14 := uint32(a[14]), uint32(a[14]>>32)
+	e15, o15 := uint32(a[15]), uint32(a[15]>>32)
+	e16, o16 := uint32(a[16]), uint32(a[16]>>32)
+	e17, o17 := uint32(a[17]), uint32(a[17]>>32)
+	e18, o18 := uint32(a[18]), uint32(a[18]>>32)
+	e19, o19 := uint32(a[19]), uint32(a[19]>>32)
+	e20, o20 := uint32(a[20]), uint32(a[20]>>32)
+	e21, o21 := uint32(a[21]), uint32(a[21]>>32)
+	e22, o22 := uint32(a[22]), uint32(a[22]>>32)
+	e23, o23 := uint32(a[23]), uint32(a[23]>>32)
+	e24, o24 := uint32(a[24]), uint32(a[24]>>32)
+
+	for _, rc := range roundConstantsInterleaved[24-rounds:] {
+		// θ step
+		ce0 := e00 ^ e05 ^ e10 ^ e15 ^ e20
+		co0 := o00 ^ o05 ^ o10 ^ o15 ^ o20
+		ce1 := e01 ^ e06 ^ e11 ^ e16 ^ e21
+		co1 := o01 ^ o06 ^ o11 ^ o16 ^ o21
+		ce2 := e02 ^ e07 ^ e12 ^ e17 ^ e22
+		co2 := o02 ^ o07 ^ o12 ^ o17 ^ o22
+		ce3 := e03 ^ e08 ^ e13 ^ e18 ^ e23
+		co3 := o03 ^ o08 ^ o13 ^ o18 ^ o23
+		ce4 := e04 ^ e09 ^ e14 ^ e19 ^ e24
+		co4 := o04 ^ o09 ^ o14 ^ o19 ^ o24
+		de0 := ce4 ^ (co1<<1 | co1>>31)
+		do0 := co4 ^ ce1
+		de1 := ce0 ^ (co2<<1 | co2>>31)
+		do1 := co0 ^ ce2
+		de2 := ce1 ^ (co3<<1 | co3>>31)
+		do2 := co1 ^ ce3
+		de3 := ce2 ^ (co4<<1 | co4>>31)
+		do3 := co2 ^ ce4
+		de4 := ce3 ^ (co0<<1 | co0>>31)
+		do4 := co3 ^ ce0
+
+		// ρ and π steps
+		e00 ^= de0
+		o00 ^= do0
+		be00, bo00 := e00, o00
+		e01 ^= de1
+		o01 ^= do1
+		be10, bo10 := o01<<1|o01>>31, e01
+		e02 ^= de2
+		o02 ^= do2
+		be20, bo20 := e02<<31|e02>>1, o02<<31|o02>>1
+		e03 ^= de3
+		o03 ^= do3
+		be05, bo05 := e03<<14|e03>>18, o03<<14|o03>>18
+		e04 ^= de4
+		o04 ^= do4
+		be15, bo15 := o04<<14|o04>>18, e04<<13|e04>>19
+		e05 ^= de0
+		o05 ^= do0
+		be16, bo16 := e05<<18|e05>>14, o05<<18|o05>>14
+		e06 ^= de1
+		o06 ^= do1
+		be01, bo01 := e06<<22|e06>>10, o06<<22|o06>>10
+		e07 ^= de2
+		o07 ^= do2
+		be11, bo11 := e07<<3|e07>>29, o07<<3|o07>>29
+		e08 ^= de3
+		o08 ^= do3
+		be21, bo21 := o08<<28|o08>>4, e08<<27|e08>>5
+		e09 ^= de4
+		o09 ^= do4
+		be06, bo06 := e09<<10|e09>>22, o09<<10|o09>>22
+		e10 ^= de0
+		o10 ^= do0
+		be07, bo07 := o10<<2|o10>>30, e10<<1|e10>>31
+		e11 ^= de1
+		o11 ^= do1
+		be17, bo17 := e11<<5|e11>>27, o11<<5|o11>>27
+		e12 ^= de2
+		o12 ^= do2
+		be02, bo02 := o12<<22|o12>>10, e12<<21|e12>>11
+		e13 ^= de3
+		o13 ^= do3
+		be12, bo12 := o13<<13|o13>>19, e13<<12|e13>>20
+		e14 ^= de4
+		o14 ^= do4
+		be22, bo22 := o14<<20|o14>>12, e14<<19|e14>>13
+		e15 ^= de0
+		o15 ^= do0
+		be23, bo23 := o15<<21|o15>>11, e15<<20|e15>>12
+		e16 ^= de1
+		o16 ^= do1
+		be08, bo08 := o16<<23|o16>>9, e16<<22|e16>>10
+		e17 ^= de2
+		o17 ^= do2
+		be18, bo18 := o17<<8|o17>>24, e17<<7|e17>>25
+		e18 ^= de3
+		o18 ^= do3
+		be03, bo03 := o18<<11|o18>>21, e18<<10|e18>>22
+		e19 ^= de4
+		o19 ^= do4
+		be13, bo13 := e19<<4|e19>>28, o19<<4|o19>>28
+		e20 ^= de0
+		o20 ^= do0
+		be14, bo14 := e20<<9|e20>>23, o20<<9|o20>>23
+		e21 ^= de1
+		o21 ^= do1
+		be24, bo24 := e21<<1|e21>>31, o21<<1|o21>>31
+		e22 ^= de2
+		o22 ^= do2
+		be09, bo09 := o22<<31|o22>>1, e22<<30|e22>>2
+		e23 ^= de3
+		o23 ^= do3
+		be19, bo19 := e23<<28|e23>>4, o23<<28|o23>>4
+		e24 ^= de4
+		o24 ^= do4
+		be04, bo04 := e24<<7|e24>>25, o24<<7|o24>>25
+
+		// χ step
+		e00 = be00 ^ (^be01 & be02)
+		o00 = bo00 ^ (^bo01 & bo02)
+		e01 = be01 ^ (^be02 & be03)
+		o01 = bo01 ^ (^bo02 & bo03)
+		e02 = be02 ^ (^be03 & be04)
+		o02 = bo02 ^ (^bo03 & bo04)
+		e03 = be03 ^ (^be04 & be00)
+		o03 = bo03 ^ (^bo04 & bo00)
+		e04 = be04 ^ (^be00 & be01)
+		o04 = bo04 ^ (^bo00 & bo01)
+		e05 = be05 ^ (^be06 & be07)
+		o05 = bo05 ^ (^bo06 & bo07)
+		e06 = be06 ^ (^be07 & be08)
+		o06 = bo06 ^ (^bo07 & bo08)
+		e07 = be07 ^ (^be08 & be09)
+		o07 = bo07 ^ (^bo08 & bo09)
+		e08 = be08 ^ (^be09 & be05)
+		o08 = bo08 ^ (^bo09 & bo05)
+		e09 = be09 ^ (^be05 & be06)
+		o09 = bo09 ^ (^bo05 & bo06)
+		e10 = be10 ^ (^be11 & be12)
+		o10 = bo10 ^ (^bo11 & bo12)
+		e11 = be11 ^ (^be12 & be13)
+		o11 = bo11 ^ (^bo12 & bo13)
+		e12 = be12 ^ (^be13 & be14)
+		o12 = bo12 ^ (^bo13 & bo14)
+		e13 = be13 ^ (^be14 & be10)
+		o13 = bo13 ^ (^bo14 & bo10)
+		e14 = be14 ^ (^be10 & be11)
+		o14 = bo14 ^ (^bo10 & bo11)
+		e15 = be15 ^ (^be16 & be17)
+		o15 = bo15 ^ (^bo16 & bo17)
+		e16 = be16 ^ (^be17 & be18)
+		o16 = bo16 ^ (^bo17 & bo18)
+		e17 = be17 ^ (^be18 & be19)
+		o17 = bo17 ^ (^bo18 & bo19)
+		e18 = be18 ^ (^be19 & be15)
+		o18 = bo18 ^ (^bo19 & bo15)
+		e19 = be19 ^ (^be15 & be16)
+		o19 = bo19 ^ (^bo15 & bo16)
+		e20 = be20 ^ (^be21 & be22)
+		o20 = bo20 ^ (^bo21 & bo22)
+		e21 = be21 ^ (^be22 & be23)
+		o21 = bo21 ^ (^bo22 & bo23)
+		e22 = be22 ^ (^be23 & be24)
+		o22 = bo22 ^ (^bo23 & bo24)
+		e23 = be23 ^ (^be24 & be20)
+		o23 = bo23 ^ (^bo24 & bo20)
+		e24 = be24 ^ (^be20 & be21)
+		o24 = bo24 ^ (^bo20 & bo21)
+
+		// ι step
+		e00 ^= rc[0]
+		o00 ^= rc[1]
+	}
+
+	a[0] = uint64(o00)<<32 | uint64(e00)
+	a[1] = uint64(o01)<<32 | uint64(e01)
+	a[2] = uint64(o02)<<32 | uint64(e02)
+	a[3] = uint64(o03)<<32 | uint64(e03)
+	a[4] = uint64(o04)<<32 | uint64(e04)
+	a[5] = uint64(o05)<<32 | uint64(e05)
+	a[6] = uint64(o06)<<32 | uint64(e06)
+	a[7] = uint64(o07)<<32 | uint64(e07)
+	a[8] = uint64(o08)<<32 | uint64(e08)
+	a[9] = uint64(o09)<<32 | uint64(e09)
+	a[10] = uint64(o10)<<32 | uint64(e10)
+	a[11] = uint64(o11)<<32 | uint64(e11)
+	a[12] = uint64(o12)<<32 | uint64(e12)
+	a[13] = uint64(o13)<<32 | uint64(e13)
+	a[14] = uint64(o14)<<32 | uint64(e14)
+	a[15] = uint64(o15)<<32 | uint64(e15)
+	a[16] = uint64(o16)<<32 | uint64(e16)
+	a[17] = uint64(o17)<<32 | uint64(e17)
+	a[18] = uint64(o18)<<32 | uint64(e18)
+	a[19] = uint64(o19)<<32 | uint64(e19)
+	a[20] = uint64(o20)<<32 | uint64(e20)
+	a[21] = uint64(o21)<<32 | uint64(e21)
+	a[22] = uint64(o22)<<32 | uint64(e22)
+	a[23] = uint64(o23)<<32 | uint64(e23)
+	a[24] = uint64(o24)<<32 | uint64(e24)
+}
@@ -2,7 +2,15 @@
 
 package sha3_fast
 
+import "math/bits"
+
 // Use generic implementation
-func keccakF1600(a *[25]uint64) {
-	keccakF1600Generic(a)
+var keccakF1600 = keccakF1600Generic
+
+// On 32-bit platforms the bit-interleaved implementation is faster, as it
+// only needs 32-bit rotations
+func init() {
+	if bits.UintSize == 32 {
+		useInterleavedLanes(keccakF1600Interleaved)
+	}
 }
@@ -0,0 +1,274 @@
+package sha3_fast
+
+// This file provides generic implementations of the Keccak-f permutations
+// narrower than 1600 bits, whose lanes are 8, 16 and 32 bits wide, and a
+// byte-oriented sponge on top of them. They are meant for lightweight
+// constructions and experiments rather than speed.
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// The number of rounds of the full Keccak-f permutations of each width,
+// 12+2l for lanes of 2^l bits.
+const (
+	KeccakF200Rounds = 18
+	KeccakF400Rounds = 20
+	KeccakF800Rounds = 22
+)
+
+// KeccakP200 applies the last rounds rounds of the Keccak-f[200] permutation,
+// i.e. Keccak-p[200, rounds], to the 8-bit lanes of a, indexed by x+5y. It
+// panics if rounds isn't between 1 and KeccakF200Rounds.
+func KeccakP200(a *[25]uint8, rounds int) {
+	checkRounds(rounds, KeccakF200Rounds)
+	var b [25]uint8
+	var c, d [5]uint8
+	for _, roundConstant := range rc[KeccakF200Rounds-rounds : KeccakF200Rounds] {
+		// θ step
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ bits.RotateLeft8(c[(x+1)%5], 1)
+		}
+		for i := range a {
+			a[i] ^= d[i%5]
+		}
+
+		// ρ and π steps
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft8(a[x+5*y], rotationOffsets[x+5*y]%8)
+			}
+		}
+
+		// χ step
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+
+		// ι step
+		a[0] ^= uint8(roundConstant)
+	}
+}
+
+// KeccakP400 applies the last rounds rounds of the Keccak-f[400] permutation,
+// i.e. Keccak-p[400, rounds], to the 16-bit lanes of a, indexed by x+5y. It
+// panics if rounds isn't between 1 and KeccakF400Rounds.
+func KeccakP400(a *[25]uint16, rounds int) {
+	checkRounds(rounds, KeccakF400Rounds)
+	var b [25]uint16
+	var c, d [5]uint16
+	for _, roundConstant := range rc[KeccakF400Rounds-rounds : KeccakF400Rounds] {
+		// θ step
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ bits.RotateLeft16(c[(x+1)%5], 1)
+		}
+		for i := range a {
+			a[i] ^= d[i%5]
+		}
+
+		// ρ and π steps
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft16(a[x+5*y], rotationOffsets[x+5*y]%16)
+			}
+		}
+
+		// χ step
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+
+		// ι step
+		a[0] ^= uint16(roundConstant)
+	}
+}
+
+// KeccakP800 applies the last rounds rounds of the Keccak-f[800] permutation,
+// i.e. Keccak-p[800, rounds], to the 32-bit lanes of a, indexed by x+5y. It
+// panics if rounds isn't between 1 and KeccakF800Rounds.
+func KeccakP800(a *[25]uint32, rounds int) {
+	checkRounds(rounds, KeccakF800Rounds)
+	var b [25]uint32
+	var c, d [5]uint32
+	for _, roundConstant := range rc[KeccakF800Rounds-rounds : KeccakF800Rounds] {
+		// θ step
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ bits.RotateLeft32(c[(x+1)%5], 1)
+		}
+		for i := range a {
+			a[i] ^= d[i%5]
+		}
+
+		// ρ and π steps
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft32(a[x+5*y], rotationOffsets[x+5*y]%32)
+			}
+		}
+
+		// χ step
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+
+		// ι step
+		a[0] ^= uint32(roundConstant)
+	}
+}
+
+// checkRounds panics if rounds isn't a valid number of rounds for a
+// permutation whose full number of rounds is full. The round constants of the
+// narrower permutations are those of KeccakF-1600 truncated to their lanes.
+func checkRounds(rounds, full int) {
+	if rounds < 1 || rounds > full {
+		panic("sha3: invalid number of rounds")
+	}
+}
+
+// permuteBytes200 applies Keccak-p[200, rounds] to the 25 byte state s.
+func permuteBytes200(s []byte, rounds int) {
+	var a [25]uint8
+	copy(a[:], s)
+	KeccakP200(&a, rounds)
+	copy(s, a[:])
+}
+
+// permuteBytes400 applies Keccak-p[400, rounds] to the 50 byte state s, whose
+// lanes are little-endian.
+func permuteBytes400(s []byte, rounds int) {
+	var a [25]uint16
+	for i := range a {
+		a[i] = uint16(s[2*i]) | uint16(s[2*i+1])<<8
+	}
+	KeccakP400(&a, rounds)
+	for i, lane := range a {
+		s[2*i], s[2*i+1] = byte(lane), byte(lane>>8)
+	}
+}
+
+// permuteBytes800 applies Keccak-p[800, rounds] to the 100 byte state s, whose
+// lanes are little-endian.
+func permuteBytes800(s []byte, rounds int) {
+	var a [25]uint32
+	for i := range a {
+		a[i] = uint32(s[4*i]) | uint32(s[4*i+1])<<8 | uint32(s[4*i+2])<<16 | uint32(s[4*i+3])<<24
+	}
+	KeccakP800(&a, rounds)
+	for i, lane := range a {
+		s[4*i], s[4*i+1], s[4*i+2], s[4*i+3] = byte(lane), byte(lane>>8), byte(lane>>16), byte(lane>>24)
+	}
+}
+
+// smallSponge is a byte-oriented sponge on one of the narrower permutations,
+// with the same padding as state.
+type smallSponge struct {
+	s       [100]byte // the state, of which the first width/8 bytes are used
+	width   int       // the width of the permutation in bits
+	rate    int       // the number of bytes of state to use
+	rounds  int       // the number of rounds of the permutation
+	dsbyte  byte      // the domain separation bits and first bit of padding
+	permute func(s []byte, rounds int)
+
+	pos       int  // the position in the rate of the next byte in or out
+	squeezing bool // whether the sponge is being squeezed
+}
+
+// NewSmallSponge creates a sponge on Keccak-p[width, rounds], where width is
+// 200, 400 or 800 bits, that absorbs rate bytes per call of the permutation.
+// dsbyte holds the domain separation bits followed by the first bit of the
+// padding, as for NewSponge. The rate must be between 1 byte and one byte less
+// than the width of the permutation, and rounds between 1 and the full number
+// of rounds of the permutation, such as KeccakF800Rounds.
+func NewSmallSponge(width, rate int, dsbyte byte, rounds int) (ShakeHash, error) {
+	d := &smallSponge{width: width, rate: rate, rounds: rounds, dsbyte: dsbyte}
+	var full int
+	switch width {
+	case 200:
+		d.permute, full = permuteBytes200, KeccakF200Rounds
+	case 400:
+		d.permute, full = permuteBytes400, KeccakF400Rounds
+	case 800:
+		d.permute, full = permuteBytes800, KeccakF800Rounds
+	default:
+		return nil, fmt.Errorf("sha3: invalid permutation width %d", width)
+	}
+	if rate <= 0 || rate >= width/8 {
+		return nil, fmt.Errorf("sha3: invalid sponge rate %d for width %d", rate, width)
+	}
+	if dsbyte == 0 {
+		return nil, fmt.Errorf("sha3: sponge domain separation byte has no padding bit")
+	}
+	if rounds < 1 || rounds > full {
+		return nil, fmt.Errorf("sha3: invalid number of sponge rounds %d", rounds)
+	}
+	return d, nil
+}
+
+// Write absorbs more data into the sponge. It panics if input is written to
+// it after output has been read from it.
+func (d *smallSponge) Write(p []byte) (int, error) {
+	if d.squeezing {
+		panic("sha3: write to sponge after read")
+	}
+	for _, b := range p {
+		d.s[d.pos] ^= b
+		d.pos++
+		if d.pos == d.rate {
+			d.permute(d.s[:d.width/8], d.rounds)
+			d.pos = 0
+		}
+	}
+	return len(p), nil
+}
+
+// Read squeezes an arbitrary number of bytes from the sponge.
+func (d *smallSponge) Read(out []byte) (int, error) {
+	if !d.squeezing {
+		d.s[d.pos] ^= d.dsbyte
+		if d.dsbyte&0x80 != 0 && d.pos == d.rate-1 {
+			d.permute(d.s[:d.width/8], d.rounds)
+		}
+		d.s[d.rate-1] ^= 0x80
+		d.permute(d.s[:d.width/8], d.rounds)
+		d.pos = 0
+		d.squeezing = true
+	}
+	for i := range out {
+		if d.pos == d.rate {
+			d.permute(d.s[:d.width/8], d.rounds)
+			d.pos = 0
+		}
+		out[i] = d.s[d.pos]
+		d.pos++
+	}
+	return len(out), nil
+}
+
+// Clone returns a copy of the sponge in its current state.
+func (d *smallSponge) Clone() ShakeHash {
+	ret := *d
+	return &ret
+}
+
+// Reset resets the sponge to its initial state.
+func (d *smallSponge) Reset() {
+	d.s = [100]byte{}
+	d.pos = 0
+	d.squeezing = false
+}
@@ -0,0 +1,121 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// TestKeccakPSmallZero checks the full narrower permutations of the all-zero
+// state against the intermediate values of the Keccak Code Package, as
+// little-endian bytes.
+func TestKeccakPSmallZero(t *testing.T) {
+	for _, tc := range []struct {
+		width   int
+		rounds  int
+		permute func(s []byte, rounds int)
+		want    string
+	}{
+		{200, KeccakF200Rounds, permuteBytes200,
+			"3c2826841cb35c171eaae9b811134ceaa3852c69d2c5abafea"},
+		{400, KeccakF400Rounds, permuteBytes400,
+			"f509ac40a90ff5149fe8a0ecd15b7078f0ef8fbf3703526075dcc90e76e74652a159815d956d146e3e63ee58ff714c718eb3"},
+		{800, KeccakF800Rounds, permuteBytes800,
+			"5dd431e5fbc604f499bfa0232f45f8f142d0ff5178f539e5a7800bf0643697af4cf35abf24247a22152717888458689f" +
+				"54d05cb10efcf41b91fa66619a599e1a1f0a97a3879665ab688dabaf15104be7981a0034f3ef1941760e0a937080b28796e9ef11"},
+	} {
+		s := make([]byte, tc.width/8)
+		tc.permute(s, tc.rounds)
+		if got := hex.EncodeToString(s); got != tc.want {
+			t.Errorf("Keccak-f[%d]: got %s, want %s", tc.width, got, tc.want)
+		}
+	}
+}
+
+// TestKeccakPSmallRoundsPanic checks that the permutations refuse numbers of
+// rounds that they don't have.
+func TestKeccakPSmallRoundsPanic(t *testing.T) {
+	for _, f := range []func(){
+		func() { KeccakP200(new([25]uint8), 0) },
+		func() { KeccakP200(new([25]uint8), KeccakF200Rounds+1) },
+		func() { KeccakP400(new([25]uint16), KeccakF400Rounds+1) },
+		func() { KeccakP800(new([25]uint32), KeccakF800Rounds+1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("invalid number of rounds didn't panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+// TestSmallSpongePadding checks the output of a sponge for the empty message
+// against the padded state permuted by hand.
+func TestSmallSpongePadding(t *testing.T) {
+	for _, rate := range []int{1, 7, 18, 99} {
+		h, err := NewSmallSponge(800, rate, 0x1f, 12)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]byte, rate)
+		h.Read(got)
+
+		s := make([]byte, 100)
+		s[0] ^= 0x1f
+		s[rate-1] ^= 0x80
+		permuteBytes800(s, 12)
+		if !bytes.Equal(got, s[:rate]) {
+			t.Errorf("rate=%d: got %x, want %x", rate, got, s[:rate])
+		}
+	}
+}
+
+// TestSmallSpongeWrites checks that the output doesn't depend on how the
+// input and output are split, and that Clone and Reset work.
+func TestSmallSpongeWrites(t *testing.T) {
+	for _, width := range []int{200, 400, 800} {
+		msg := sequentialBytes(3*width/8 + 5)
+		h, err := NewSmallSponge(width, width/16, 0x06, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.Write(msg)
+		want := make([]byte, width/4+3)
+		h.Clone().Read(want)
+
+		h.Reset()
+		for i := range msg {
+			h.Write(msg[i : i+1])
+		}
+		got := make([]byte, len(want))
+		for i := range got {
+			h.Read(got[i : i+1])
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("width=%d: got %x, want %x", width, got, want)
+		}
+	}
+}
+
+func TestNewSmallSpongeInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		width, rate int
+		dsbyte      byte
+		rounds      int
+	}{
+		{1600, 8, 0x06, 24},
+		{100, 8, 0x06, 12},
+		{200, 0, 0x06, 18},
+		{200, 25, 0x06, 18},
+		{400, 8, 0, 20},
+		{400, 8, 0x06, 21},
+		{800, 8, 0x06, 0},
+	} {
+		if _, err := NewSmallSponge(tc.width, tc.rate, tc.dsbyte, tc.rounds); err == nil {
+			t.Errorf("NewSmallSponge(%d, %d, %#x, %d) succeeded", tc.width, tc.rate, tc.dsbyte, tc.rounds)
+		}
+	}
+}
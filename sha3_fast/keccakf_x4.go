@@ -0,0 +1,46 @@
+package sha3_fast
+
+// KeccakF1600x4 applies the full 24 round KeccakF-1600 permutation to four
+// independent states at once, where a[i][j] is lane i of state j. On amd64
+// with AVX2 the four states are permuted in parallel, which makes it faster
+// than four calls to the permutation for batch hashing and tree modes.
+// Elsewhere each state is permuted in turn.
+func KeccakF1600x4(a *[25][4]uint64) {
+	keccakF1600x4(a)
+}
+
+// keccakF1600x4 is replaced by the architecture specific files if they have a
+// parallel implementation.
+var keccakF1600x4 = keccakF1600x4Scalar
+
+// keccakF1600x4Scalar permutes each of the states in turn with the fastest
+// single state implementation.
+func keccakF1600x4Scalar(a *[25][4]uint64) {
+	f := keccakF1600
+	if interleavedLanes {
+		f = onOrdinaryLanes(keccakF1600)
+	}
+	permuteEach(a, f)
+}
+
+// keccakF1600x4Generic permutes each of the states in turn with the generic
+// implementation, as a reference for the parallel implementations.
+func keccakF1600x4Generic(a *[25][4]uint64) {
+	permuteEach(a, keccakF1600Generic)
+}
+
+// permuteEach applies f to each of the states in turn, through an aligned
+// copy of its lanes.
+func permuteEach(a *[25][4]uint64, f func(a *[25]uint64)) {
+	var storage laneStorage
+	s := alignLanes(&storage)
+	for j := 0; j < 4; j++ {
+		for i := range s {
+			s[i] = a[i][j]
+		}
+		f(s)
+		for i := range s {
+			a[i][j] = s[i]
+		}
+	}
+}
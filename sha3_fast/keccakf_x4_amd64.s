@@ -0,0 +1,255 @@
+// +build amd64,!appengine,!gccgo
+
+// An AVX2 implementation of the KeccakF-1600 permutation that permutes four
+// independent states at once. The states are stored lane by lane, so that
+// lane i of all four states is in the 32 bytes at 32*i and is loaded into a
+// single YMM register.
+//
+// There aren't enough registers for the whole state, so each round reads the
+// state from one buffer and writes the new state to the other, alternating
+// between the caller's states and the frame. Within a round the θ effect D
+// is kept in Y5-Y9 and a plane of the output of the π step in Y10-Y14, which
+// the χ step combines in Y0-Y4, with Y15 as a temporary.
+
+// mKeccakRoundx4 applies a round of the permutation to the states at src,
+// storing the result at dst, with the round constant at rc.
+#define mKeccakRoundx4(src, dst, rc) \
+	/* θ step, the column parities C in Y0-Y4 */ \
+	VMOVDQU (src), Y0; \
+	VPXOR 160(src), Y0, Y0; \
+	VPXOR 320(src), Y0, Y0; \
+	VPXOR 480(src), Y0, Y0; \
+	VPXOR 640(src), Y0, Y0; \
+	VMOVDQU 32(src), Y1; \
+	VPXOR 192(src), Y1, Y1; \
+	VPXOR 352(src), Y1, Y1; \
+	VPXOR 512(src), Y1, Y1; \
+	VPXOR 672(src), Y1, Y1; \
+	VMOVDQU 64(src), Y2; \
+	VPXOR 224(src), Y2, Y2; \
+	VPXOR 384(src), Y2, Y2; \
+	VPXOR 544(src), Y2, Y2; \
+	VPXOR 704(src), Y2, Y2; \
+	VMOVDQU 96(src), Y3; \
+	VPXOR 256(src), Y3, Y3; \
+	VPXOR 416(src), Y3, Y3; \
+	VPXOR 576(src), Y3, Y3; \
+	VPXOR 736(src), Y3, Y3; \
+	VMOVDQU 128(src), Y4; \
+	VPXOR 288(src), Y4, Y4; \
+	VPXOR 448(src), Y4, Y4; \
+	VPXOR 608(src), Y4, Y4; \
+	VPXOR 768(src), Y4, Y4; \
+	/* D[x] = C[x-1] ^ rot(C[x+1], 1) in Y5-Y9 */ \
+	VPSLLQ $1, Y1, Y15; \
+	VPSRLQ $63, Y1, Y5; \
+	VPOR Y15, Y5, Y5; \
+	VPXOR Y4, Y5, Y5; \
+	VPSLLQ $1, Y2, Y15; \
+	VPSRLQ $63, Y2, Y6; \
+	VPOR Y15, Y6, Y6; \
+	VPXOR Y0, Y6, Y6; \
+	VPSLLQ $1, Y3, Y15; \
+	VPSRLQ $63, Y3, Y7; \
+	VPOR Y15, Y7, Y7; \
+	VPXOR Y1, Y7, Y7; \
+	VPSLLQ $1, Y4, Y15; \
+	VPSRLQ $63, Y4, Y8; \
+	VPOR Y15, Y8, Y8; \
+	VPXOR Y2, Y8, Y8; \
+	VPSLLQ $1, Y0, Y15; \
+	VPSRLQ $63, Y0, Y9; \
+	VPOR Y15, Y9, Y9; \
+	VPXOR Y3, Y9, Y9; \
+	/* ρ and π steps into Y10-Y14 a plane at a time, then the χ step */ \
+	VPXOR (src), Y5, Y10; \
+	VPXOR 192(src), Y6, Y11; \
+	VPSLLQ $44, Y11, Y15; \
+	VPSRLQ $20, Y11, Y11; \
+	VPOR Y15, Y11, Y11; \
+	VPXOR 384(src), Y7, Y12; \
+	VPSLLQ $43, Y12, Y15; \
+	VPSRLQ $21, Y12, Y12; \
+	VPOR Y15, Y12, Y12; \
+	VPXOR 576(src), Y8, Y13; \
+	VPSLLQ $21, Y13, Y15; \
+	VPSRLQ $43, Y13, Y13; \
+	VPOR Y15, Y13, Y13; \
+	VPXOR 768(src), Y9, Y14; \
+	VPSLLQ $14, Y14, Y15; \
+	VPSRLQ $50, Y14, Y14; \
+	VPOR Y15, Y14, Y14; \
+	VPANDN Y12, Y11, Y0; \
+	VPXOR Y10, Y0, Y0; \
+	VPBROADCASTQ rc, Y15; \
+	VPXOR Y15, Y0, Y0; \
+	VMOVDQU Y0, (dst); \
+	VPANDN Y13, Y12, Y1; \
+	VPXOR Y11, Y1, Y1; \
+	VMOVDQU Y1, 32(dst); \
+	VPANDN Y14, Y13, Y2; \
+	VPXOR Y12, Y2, Y2; \
+	VMOVDQU Y2, 64(dst); \
+	VPANDN Y10, Y14, Y3; \
+	VPXOR Y13, Y3, Y3; \
+	VMOVDQU Y3, 96(dst); \
+	VPANDN Y11, Y10, Y4; \
+	VPXOR Y14, Y4, Y4; \
+	VMOVDQU Y4, 128(dst); \
+	VPXOR 96(src), Y8, Y10; \
+	VPSLLQ $28, Y10, Y15; \
+	VPSRLQ $36, Y10, Y10; \
+	VPOR Y15, Y10, Y10; \
+	VPXOR 288(src), Y9, Y11; \
+	VPSLLQ $20, Y11, Y15; \
+	VPSRLQ $44, Y11, Y11; \
+	VPOR Y15, Y11, Y11; \
+	VPXOR 320(src), Y5, Y12; \
+	VPSLLQ $3, Y12, Y15; \
+	VPSRLQ $61, Y12, Y12; \
+	VPOR Y15, Y12, Y12; \
+	VPXOR 512(src), Y6, Y13; \
+	VPSLLQ $45, Y13, Y15; \
+	VPSRLQ $19, Y13, Y13; \
+	VPOR Y15, Y13, Y13; \
+	VPXOR 704(src), Y7, Y14; \
+	VPSLLQ $61, Y14, Y15; \
+	VPSRLQ $3, Y14, Y14; \
+	VPOR Y15, Y14, Y14; \
+	VPANDN Y12, Y11, Y0; \
+	VPXOR Y10, Y0, Y0; \
+	VMOVDQU Y0, 160(dst); \
+	VPANDN Y13, Y12, Y1; \
+	VPXOR Y11, Y1, Y1; \
+	VMOVDQU Y1, 192(dst); \
+	VPANDN Y14, Y13, Y2; \
+	VPXOR Y12, Y2, Y2; \
+	VMOVDQU Y2, 224(dst); \
+	VPANDN Y10, Y14, Y3; \
+	VPXOR Y13, Y3, Y3; \
+	VMOVDQU Y3, 256(dst); \
+	VPANDN Y11, Y10, Y4; \
+	VPXOR Y14, Y4, Y4; \
+	VMOVDQU Y4, 288(dst); \
+	VPXOR 32(src), Y6, Y10; \
+	VPSLLQ $1, Y10, Y15; \
+	VPSRLQ $63, Y10, Y10; \
+	VPOR Y15, Y10, Y10; \
+	VPXOR 224(src), Y7, Y11; \
+	VPSLLQ $6, Y11, Y15; \
+	VPSRLQ $58, Y11, Y11; \
+	VPOR Y15, Y11, Y11; \
+	VPXOR 416(src), Y8, Y12; \
+	VPSLLQ $25, Y12, Y15; \
+	VPSRLQ $39, Y12, Y12; \
+	VPOR Y15, Y12, Y12; \
+	VPXOR 608(src), Y9, Y13; \
+	VPSLLQ $8, Y13, Y15; \
+	VPSRLQ $56, Y13, Y13; \
+	VPOR Y15, Y13, Y13; \
+	VPXOR 640(src), Y5, Y14; \
+	VPSLLQ $18, Y14, Y15; \
+	VPSRLQ $46, Y14, Y14; \
+	VPOR Y15, Y14, Y14; \
+	VPANDN Y12, Y11, Y0; \
+	VPXOR Y10, Y0, Y0; \
+	VMOVDQU Y0, 320(dst); \
+	VPANDN Y13, Y12, Y1; \
+	VPXOR Y11, Y1, Y1; \
+	VMOVDQU Y1, 352(dst); \
+	VPANDN Y14, Y13, Y2; \
+	VPXOR Y12, Y2, Y2; \
+	VMOVDQU Y2, 384(dst); \
+	VPANDN Y10, Y14, Y3; \
+	VPXOR Y13, Y3, Y3; \
+	VMOVDQU Y3, 416(dst); \
+	VPANDN Y11, Y10, Y4; \
+	VPXOR Y14, Y4, Y4; \
+	VMOVDQU Y4, 448(dst); \
+	VPXOR 128(src), Y9, Y10; \
+	VPSLLQ $27, Y10, Y15; \
+	VPSRLQ $37, Y10, Y10; \
+	VPOR Y15, Y10, Y10; \
+	VPXOR 160(src), Y5, Y11; \
+	VPSLLQ $36, Y11, Y15; \
+	VPSRLQ $28, Y11, Y11; \
+	VPOR Y15, Y11, Y11; \
+	VPXOR 352(src), Y6, Y12; \
+	VPSLLQ $10, Y12, Y15; \
+	VPSRLQ $54, Y12, Y12; \
+	VPOR Y15, Y12, Y12; \
+	VPXOR 544(src), Y7, Y13; \
+	VPSLLQ $15, Y13, Y15; \
+	VPSRLQ $49, Y13, Y13; \
+	VPOR Y15, Y13, Y13; \
+	VPXOR 736(src), Y8, Y14; \
+	VPSLLQ $56, Y14, Y15; \
+	VPSRLQ $8, Y14, Y14; \
+	VPOR Y15, Y14, Y14; \
+	VPANDN Y12, Y11, Y0; \
+	VPXOR Y10, Y0, Y0; \
+	VMOVDQU Y0, 480(dst); \
+	VPANDN Y13, Y12, Y1; \
+	VPXOR Y11, Y1, Y1; \
+	VMOVDQU Y1, 512(dst); \
+	VPANDN Y14, Y13, Y2; \
+	VPXOR Y12, Y2, Y2; \
+	VMOVDQU Y2, 544(dst); \
+	VPANDN Y10, Y14, Y3; \
+	VPXOR Y13, Y3, Y3; \
+	VMOVDQU Y3, 576(dst); \
+	VPANDN Y11, Y10, Y4; \
+	VPXOR Y14, Y4, Y4; \
+	VMOVDQU Y4, 608(dst); \
+	VPXOR 64(src), Y7, Y10; \
+	VPSLLQ $62, Y10, Y15; \
+	VPSRLQ $2, Y10, Y10; \
+	VPOR Y15, Y10, Y10; \
+	VPXOR 256(src), Y8, Y11; \
+	VPSLLQ $55, Y11, Y15; \
+	VPSRLQ $9, Y11, Y11; \
+	VPOR Y15, Y11, Y11; \
+	VPXOR 448(src), Y9, Y12; \
+	VPSLLQ $39, Y12, Y15; \
+	VPSRLQ $25, Y12, Y12; \
+	VPOR Y15, Y12, Y12; \
+	VPXOR 480(src), Y5, Y13; \
+	VPSLLQ $41, Y13, Y15; \
+	VPSRLQ $23, Y13, Y13; \
+	VPOR Y15, Y13, Y13; \
+	VPXOR 672(src), Y6, Y14; \
+	VPSLLQ $2, Y14, Y15; \
+	VPSRLQ $62, Y14, Y14; \
+	VPOR Y15, Y14, Y14; \
+	VPANDN Y12, Y11, Y0; \
+	VPXOR Y10, Y0, Y0; \
+	VMOVDQU Y0, 640(dst); \
+	VPANDN Y13, Y12, Y1; \
+	VPXOR Y11, Y1, Y1; \
+	VMOVDQU Y1, 672(dst); \
+	VPANDN Y14, Y13, Y2; \
+	VPXOR Y12, Y2, Y2; \
+	VMOVDQU Y2, 704(dst); \
+	VPANDN Y10, Y14, Y3; \
+	VPXOR Y13, Y3, Y3; \
+	VMOVDQU Y3, 736(dst); \
+	VPANDN Y11, Y10, Y4; \
+	VPXOR Y14, Y4, Y4; \
+	VMOVDQU Y4, 768(dst)
+
+// func keccakF1600x4AVX2(a *[25][4]uint64, rc *[24]uint64)
+TEXT ·keccakF1600x4AVX2(SB), 0, $800-16
+	MOVQ a+0(FP), DI
+	MOVQ rc+8(FP), SI
+	MOVQ $12, CX
+
+loop:
+	// Two rounds at a time, so that the states end up back at DI
+	mKeccakRoundx4(DI, SP, (SI))
+	mKeccakRoundx4(SP, DI, 8(SI))
+	ADDQ $16, SI
+	DECQ CX
+	JNZ  loop
+
+	VZEROUPPER
+	RET
@@ -0,0 +1,78 @@
+package sha3_fast
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestKeccakF1600x4 checks the parallel permutation, and the scalar fallback,
+// against permuting each state with the generic implementation.
+func TestKeccakF1600x4(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n < 10; n++ {
+		var a, want [25][4]uint64
+		for i := range a {
+			for j := range a[i] {
+				a[i][j] = rng.Uint64()
+			}
+		}
+		want = a
+		keccakF1600x4Generic(&want)
+		for _, impl := range []struct {
+			name string
+			f    func(a *[25][4]uint64)
+		}{{"default", KeccakF1600x4}, {"scalar", keccakF1600x4Scalar}} {
+			got := a
+			impl.f(&got)
+			if got != want {
+				t.Fatalf("%s: got %x, want %x", impl.name, got, want)
+			}
+		}
+	}
+}
+
+// TestKeccakF1600x4Independent checks that the states don't affect each
+// other, by permuting one state at a time with the others zero.
+func TestKeccakF1600x4Independent(t *testing.T) {
+	var lanes, want, zero [25]uint64
+	for i := range lanes {
+		lanes[i] = uint64(i) * 0x9e3779b97f4a7c15
+	}
+	want = lanes
+	keccakF1600Generic(&want)
+	keccakF1600Generic(&zero)
+	for j := 0; j < 4; j++ {
+		var a [25][4]uint64
+		for i := range a {
+			a[i][j] = lanes[i]
+		}
+		KeccakF1600x4(&a)
+		for i := range a {
+			for k := range a[i] {
+				expected := zero[i]
+				if k == j {
+					expected = want[i]
+				}
+				if a[i][k] != expected {
+					t.Fatalf("state %d set: lane %d of state %d is %x, want %x", j, i, k, a[i][k], expected)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkPermutationx4(b *testing.B) {
+	b.SetBytes(4 * 200)
+	var a [25][4]uint64
+	for i := 0; i < b.N; i++ {
+		KeccakF1600x4(&a)
+	}
+}
+
+func BenchmarkPermutationx4Scalar(b *testing.B) {
+	b.SetBytes(4 * 200)
+	var a [25][4]uint64
+	for i := 0; i < b.N; i++ {
+		keccakF1600x4Scalar(&a)
+	}
+}
@@ -0,0 +1,72 @@
+package sha3_fast
+
+import "math/bits"
+
+// rotationOffsets holds the rotation of each lane in the ρ step, indexed by
+// x+5y.
+var rotationOffsets = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// KeccakP1600 applies the last rounds rounds of the KeccakF-1600 permutation,
+// i.e. Keccak-p[1600, rounds], to the lanes of a, indexed by x+5y. It panics
+// if rounds isn't between 1 and 24. It always uses the generic implementation,
+// so KeccakF1600With is faster for the full permutation.
+func KeccakP1600(a *[25]uint64, rounds int) {
+	checkRounds(rounds, fullRounds)
+	keccakP1600Generic(a, rounds)
+}
+
+// keccakP1600 applies the last rounds rounds of the KeccakF-1600 permutation
+// to the lanes of a sponge, which are bit-interleaved if interleavedLanes is
+// set. It is used for reduced-round sponges, which are rare enough that the
+// assembly implementations only handle the full 24 rounds.
+func keccakP1600(a *[25]uint64, rounds int) {
+	if interleavedLanes {
+		keccakP1600Interleaved(a, rounds)
+		return
+	}
+	keccakP1600Generic(a, rounds)
+}
+
+// keccakP1600Generic applies the last rounds rounds of the KeccakF-1600
+// permutation to ordinary lanes. It follows the specification step by step
+// rather than unrolling the rounds like keccakF1600Generic, so that it can
+// start at any round.
+func keccakP1600Generic(a *[25]uint64, rounds int) {
+	var b [25]uint64
+	var c, d [5]uint64
+	for _, roundConstant := range rc[24-rounds:] {
+		// θ step
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+		}
+		for i := range a {
+			a[i] ^= d[i%5]
+		}
+
+		// ρ and π steps
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(a[x+5*y], rotationOffsets[x+5*y])
+			}
+		}
+
+		// χ step
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+
+		// ι step
+		a[0] ^= roundConstant
+	}
+}
@@ -0,0 +1,293 @@
+package sha3_fast
+
+// This file implements Kravatte, the instance of the Farfalle construction on
+// Keccak-p[1600, 6], and the Kravatte-SANE session authenticated encryption
+// mode, as described in "Farfalle: parallel
+// permutation-based cryptography" by the Keccak team, with the rolling
+// functions of the updated version of Kravatte, Kravatte Achouffe.
+//
+// The outputs have not been checked against the known-answer vectors of the
+// Keccak team's XKCP, so the rolling of the masks and the encoding of the
+// strings and their suffix bits are only as right as this reading of the
+// specification. Don't rely on it interoperating with other implementations.
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+const (
+	// kravatteRounds is the number of rounds of all the permutations of
+	// Kravatte.
+	kravatteRounds = 6
+
+	// kravatteBlockSize is the width of Keccak-p[1600] in bytes.
+	kravatteBlockSize = 200
+)
+
+// ErrKravatteTag is returned when a Kravatte-SANE tag doesn't match.
+var ErrKravatteTag = errors.New("sha3: Kravatte tag verification failed")
+
+// Kravatte is an instance of the Kravatte deck function, which maps a sequence
+// of strings to an output of arbitrary length under a secret key. The strings
+// are written one at a time, and as each of them is compressed into an
+// accumulator independently the deck function of a longer sequence can be
+// computed incrementally, by cloning the instance before reading.
+type Kravatte struct {
+	k     [25]uint64 // the mask derived from the key, kept for Reset
+	kRoll [25]uint64 // the mask of the next input block, or k' when expanding
+	x     [25]uint64 // the accumulator
+
+	buf [kravatteBlockSize]byte // the current input or output block
+	n   int                     // the number of bytes in buf
+
+	// Specific to the expansion.
+	expanding bool
+	y         [25]uint64 // the rolling expansion state
+}
+
+// NewKravatte creates a Kravatte instance with the given key, which must be
+// shorter than 200 bytes.
+func NewKravatte(key []byte) (*Kravatte, error) {
+	if len(key) >= kravatteBlockSize {
+		return nil, errors.New("sha3: Kravatte key too long")
+	}
+	kv := new(Kravatte)
+	// k = p_b(K||1||0*)
+	copy(kv.buf[:], key)
+	kv.buf[len(key)] = 0x01
+	bytesToLanes(&kv.k, kv.buf[:])
+	KeccakP1600(&kv.k, kravatteRounds)
+	kv.Reset()
+	return kv, nil
+}
+
+// Reset clears the input strings, keeping the key.
+func (kv *Kravatte) Reset() {
+	kv.kRoll = kv.k
+	kv.x = [25]uint64{}
+	kv.buf = [kravatteBlockSize]byte{}
+	kv.n = 0
+	kv.expanding = false
+}
+
+// Clone returns a copy of the instance in its current state.
+func (kv *Kravatte) Clone() *Kravatte {
+	ret := *kv
+	return &ret
+}
+
+// bytesToLanes sets the lanes of a from the little-endian bytes of b.
+func bytesToLanes(a *[25]uint64, b []byte) {
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+}
+
+// rollc is the rolling function of the masks of the input blocks, which
+// updates the last 5 lanes with a linear feedback shift register.
+func rollc(a *[25]uint64) {
+	x0 := bits.RotateLeft64(a[20], 7) ^ a[21] ^ a[21]>>3
+	copy(a[20:24], a[21:25])
+	a[24] = x0
+}
+
+// rolle is the rolling function of the expansion state, which updates the
+// last 10 lanes with a non-linear feedback shift register.
+func rolle(a *[25]uint64) {
+	x0 := bits.RotateLeft64(a[15], 7) ^ bits.RotateLeft64(a[16], 18) ^ (a[17] & (a[16] >> 1))
+	copy(a[15:24], a[16:25])
+	a[24] = x0
+}
+
+// compress adds p_c of the block in buf, masked with the current mask, to
+// the accumulator and rolls the mask.
+func (kv *Kravatte) compress() {
+	var a [25]uint64
+	bytesToLanes(&a, kv.buf[:])
+	for i := range a {
+		a[i] ^= kv.kRoll[i]
+	}
+	KeccakP1600(&a, kravatteRounds)
+	for i := range a {
+		kv.x[i] ^= a[i]
+	}
+	rollc(&kv.kRoll)
+	kv.n = 0
+}
+
+// Write appends p to the current input string. It panics if output has
+// already been read.
+func (kv *Kravatte) Write(p []byte) (int, error) {
+	if kv.expanding {
+		panic("sha3: write to Kravatte after read")
+	}
+	written := len(p)
+	for len(p) > 0 {
+		n := copy(kv.buf[kv.n:], p)
+		kv.n += n
+		p = p[n:]
+		if kv.n == kravatteBlockSize {
+			kv.compress()
+		}
+	}
+	return written, nil
+}
+
+// EndString ends the current input string, so that the next write starts a
+// new one.
+func (kv *Kravatte) EndString() {
+	kv.endString(0, 0)
+}
+
+// endString ends the current input string with the first nbits bits of
+// trailing, taken from its least significant bits, and pads it. Between
+// strings the mask is rolled once more, so that the mask of the expansion is
+// distinct from those of the input blocks.
+func (kv *Kravatte) endString(trailing byte, nbits uint) {
+	if kv.expanding {
+		panic("sha3: write to Kravatte after read")
+	}
+	kv.buf[kv.n] = trailing&(1<<nbits-1) | 1<<nbits
+	for i := kv.n + 1; i < kravatteBlockSize; i++ {
+		kv.buf[i] = 0
+	}
+	kv.compress()
+	rollc(&kv.kRoll)
+}
+
+// expand starts the expansion of the strings that have been ended, which is
+// y = p_d(x) with k' as the output mask.
+func (kv *Kravatte) expand() {
+	kv.y = kv.x
+	KeccakP1600(&kv.y, kravatteRounds)
+	kv.expanding = true
+	kv.n = kravatteBlockSize
+}
+
+// Read ends the current input string, even if nothing has been written to
+// it, if it is the first read, and reads more output of the deck function of
+// the sequence of strings. It never returns an error.
+func (kv *Kravatte) Read(out []byte) (int, error) {
+	if !kv.expanding {
+		kv.endString(0, 0)
+		kv.expand()
+	}
+	return kv.read(out), nil
+}
+
+// read reads more output of a deck function that is expanding.
+func (kv *Kravatte) read(out []byte) int {
+	read := len(out)
+	for len(out) > 0 {
+		if kv.n == kravatteBlockSize {
+			// z_j = p_e(roll_e^j(y)) + k'
+			a := kv.y
+			KeccakP1600(&a, kravatteRounds)
+			for i := range a {
+				binary.LittleEndian.PutUint64(kv.buf[8*i:], a[i]^kv.kRoll[i])
+			}
+			rolle(&kv.y)
+			kv.n = 0
+		}
+		n := copy(out, kv.buf[kv.n:])
+		kv.n += n
+		out = out[n:]
+	}
+	return read
+}
+
+// kravatteSession holds the history of a Kravatte-SANE session, as the state
+// of the deck function compressing it.
+type kravatteSession struct {
+	history *Kravatte
+	e       byte // the bit alternating between messages
+}
+
+// appendString appends s followed by the suffix bits and then e to the
+// history.
+func (s *kravatteSession) appendString(str []byte, suffix byte, nbits uint) {
+	s.history.Write(str)
+	s.history.endString(suffix|s.e<<nbits, nbits+1)
+}
+
+// output reads n bytes of the deck function of the history after the first
+// skip bytes.
+func (s *kravatteSession) output(skip, n int) []byte {
+	f := s.history.Clone()
+	f.expand()
+	out := make([]byte, skip+n)
+	f.read(out)
+	return out[skip:]
+}
+
+// xorOutput xors the deck function of the history into dst, skipping the
+// first skip bytes of output.
+func (s *kravatteSession) xorOutput(dst []byte, skip int) {
+	for i, b := range s.output(skip, len(dst)) {
+		dst[i] ^= b
+	}
+}
+
+// KravatteSANE is a Kravatte-SANE session, which encrypts and authenticates a
+// sequence of messages under a key and a nonce. Each message is
+// authenticated along with all the previous ones, so a session must be used
+// by one sender and one receiver which process the same messages in the same
+// order.
+type KravatteSANE struct {
+	kravatteSession
+}
+
+// KravatteSANETagSize is the size in bytes of Kravatte-SANE tags.
+const KravatteSANETagSize = 16
+
+// NewKravatteSANE starts a Kravatte-SANE session with the given key and
+// nonce, which must never be used for another session with the same key. It
+// returns the session and its initial tag, which the sender sends to the
+// receiver so that it can compare it with its own.
+func NewKravatteSANE(key, nonce []byte) (*KravatteSANE, []byte, error) {
+	kv, err := NewKravatte(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := &KravatteSANE{kravatteSession{history: kv}}
+	kv.Write(nonce)
+	kv.EndString()
+	return s, s.output(0, KravatteSANETagSize), nil
+}
+
+// update adds the metadata and the ciphertext of a message to the history,
+// and returns the tag of the new history.
+func (s *KravatteSANE) update(ad, ciphertext []byte) []byte {
+	if len(ad) > 0 || len(ciphertext) == 0 {
+		s.appendString(ad, 0, 1)
+	}
+	if len(ciphertext) > 0 {
+		s.appendString(ciphertext, 1, 1)
+	}
+	s.e ^= 1
+	return s.output(0, KravatteSANETagSize)
+}
+
+// Wrap encrypts plaintext and authenticates it along with the metadata ad
+// and all the previous messages of the session, and returns the ciphertext
+// and the tag.
+func (s *KravatteSANE) Wrap(ad, plaintext []byte) (ciphertext, tag []byte) {
+	ciphertext = append([]byte(nil), plaintext...)
+	s.xorOutput(ciphertext, KravatteSANETagSize)
+	return ciphertext, s.update(ad, ciphertext)
+}
+
+// Unwrap checks the tag of a message and decrypts it, returning
+// ErrKravatteTag if the tag doesn't match, in which case the session must be
+// abandoned.
+func (s *KravatteSANE) Unwrap(ad, ciphertext, tag []byte) ([]byte, error) {
+	plaintext := append([]byte(nil), ciphertext...)
+	s.xorOutput(plaintext, KravatteSANETagSize)
+	if subtle.ConstantTimeCompare(tag, s.update(ad, ciphertext)) != 1 {
+		return nil, ErrKravatteTag
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,199 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"testing"
+)
+
+// The Keccak team's Kravatte test vectors aren't included, so these tests
+// check the properties of the construction: incremental and one-shot use
+// agree, string boundaries matter, and the session mode round trips and
+// detects tampering.
+
+func mustNewKravatte(t *testing.T, key []byte) *Kravatte {
+	kv, err := NewKravatte(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return kv
+}
+
+// TestKravatteKAT is where the XKCP known-answer vectors of Kravatte and
+// Kravatte-SANE belong, once they are added to testdata.
+func TestKravatteKAT(t *testing.T) {
+	t.Skip("the XKCP Kravatte vectors are not in testdata yet, so the rolling functions and string encoding are unverified")
+}
+
+func TestKeccakP1600(t *testing.T) {
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i) * 0x9e3779b97f4a7c15
+	}
+	b = a
+	keccakF1600Generic(&a)
+	KeccakP1600(&b, 24)
+	if a != b {
+		t.Errorf("KeccakP1600 with 24 rounds differs from KeccakF-1600")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("KeccakP1600 with 25 rounds didn't panic")
+		}
+	}()
+	KeccakP1600(&b, 25)
+}
+
+// TestKravatteIncremental checks that the output doesn't depend on how the
+// strings are written and read, and that a clone continues the same way.
+func TestKravatteIncremental(t *testing.T) {
+	key := []byte("kravatte key")
+	msg := sequentialBytes(3*kravatteBlockSize + 17)
+
+	kv := mustNewKravatte(t, key)
+	kv.Write(msg[:100])
+	kv.EndString()
+	kv.Write(msg[100:])
+	want := make([]byte, 3*kravatteBlockSize+5)
+	kv.Read(want)
+
+	kv = mustNewKravatte(t, key)
+	for _, b := range msg[:100] {
+		kv.Write([]byte{b})
+	}
+	kv.EndString()
+	kv.Write(msg[100:300])
+	clone := kv.Clone()
+	kv.Write(msg[300:])
+	got := make([]byte, len(want))
+	kv.Read(got[:1])
+	kv.Read(got[1:250])
+	kv.Read(got[250:])
+	if !bytes.Equal(got, want) {
+		t.Errorf("incremental output differs")
+	}
+
+	clone.Write(msg[300:])
+	clone.Read(got)
+	if !bytes.Equal(got, want) {
+		t.Errorf("clone output differs")
+	}
+
+	kv.Reset()
+	kv.Write(msg[:100])
+	kv.EndString()
+	kv.Write(msg[100:])
+	kv.Read(got)
+	if !bytes.Equal(got, want) {
+		t.Errorf("output after Reset differs")
+	}
+}
+
+// TestKravatteStrings checks that the sequences of strings with the same
+// concatenation, and different keys, give different outputs.
+func TestKravatteStrings(t *testing.T) {
+	outputs := make(map[string]string)
+	for name, strings := range map[string][]string{
+		"abc":    {"abc"},
+		"ab,c":   {"ab", "c"},
+		"a,bc":   {"a", "bc"},
+		"abc,":   {"abc", ""},
+		",abc":   {"", "abc"},
+		"empty":  {""},
+		"empty,": {"", ""},
+		"block":  {string(sequentialBytes(kravatteBlockSize))},
+	} {
+		kv := mustNewKravatte(t, []byte("key"))
+		for i, s := range strings {
+			if i > 0 {
+				kv.EndString()
+			}
+			kv.Write([]byte(s))
+		}
+		out := make([]byte, 32)
+		kv.Read(out)
+		if other, ok := outputs[string(out)]; ok {
+			t.Errorf("%q and %q give the same output", name, other)
+		}
+		outputs[string(out)] = name
+	}
+
+	kv := mustNewKravatte(t, []byte("other key"))
+	kv.Write([]byte("abc"))
+	out := make([]byte, 32)
+	kv.Read(out)
+	if outputs[string(out)] != "" {
+		t.Errorf("different keys give the same output")
+	}
+}
+
+func TestKravatteMisuse(t *testing.T) {
+	if _, err := NewKravatte(make([]byte, kravatteBlockSize)); err == nil {
+		t.Errorf("NewKravatte accepted a %d byte key", kravatteBlockSize)
+	}
+	mustNewKravatte(t, make([]byte, kravatteBlockSize-1))
+
+	kv := mustNewKravatte(t, []byte("key"))
+	kv.Read(make([]byte, 1))
+	defer func() {
+		if recover() == nil {
+			t.Errorf("write after read didn't panic")
+		}
+	}()
+	kv.Write([]byte("more"))
+}
+
+// kravatteSessionMessages are the metadata and plaintexts of the messages of
+// the session tests, including empty ones of each kind.
+var kravatteSessionMessages = [][2][]byte{
+	{[]byte("header"), []byte("hello")},
+	{nil, []byte("no metadata")},
+	{[]byte("metadata only"), nil},
+	{nil, nil},
+	{[]byte("long"), sequentialBytes(1000)},
+}
+
+func TestKravatteSANE(t *testing.T) {
+	key, nonce := []byte("SANE key"), []byte("nonce")
+	sender, tag, err := NewKravatteSANE(key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiver, receiverTag, _ := NewKravatteSANE(key, nonce)
+	if !bytes.Equal(tag, receiverTag) || len(tag) != KravatteSANETagSize {
+		t.Fatalf("initial tags %x and %x", tag, receiverTag)
+	}
+	if _, otherTag, _ := NewKravatteSANE(key, []byte("nonce2")); bytes.Equal(tag, otherTag) {
+		t.Errorf("different nonces give the same initial tag")
+	}
+
+	var ciphertexts [][]byte
+	for i, m := range kravatteSessionMessages {
+		ct, tag := sender.Wrap(m[0], m[1])
+		if len(ct) != len(m[1]) || len(tag) != KravatteSANETagSize {
+			t.Fatalf("message %d: %d byte ciphertext and %d byte tag", i, len(ct), len(tag))
+		}
+		if len(ct) > 0 && bytes.Equal(ct, m[1]) {
+			t.Errorf("message %d: ciphertext is the plaintext", i)
+		}
+		// A tampered message must be rejected by a copy of the receiver.
+		tampered := &KravatteSANE{kravatteSession{history: receiver.history.Clone(), e: receiver.e}}
+		if _, err := tampered.Unwrap(append(m[0], 1), ct, tag); err != ErrKravatteTag {
+			t.Errorf("message %d: tampered metadata gave %v", i, err)
+		}
+
+		pt, err := receiver.Unwrap(m[0], ct, tag)
+		if err != nil {
+			t.Fatalf("message %d: %v", i, err)
+		}
+		if !bytes.Equal(pt, m[1]) {
+			t.Errorf("message %d: decrypted to %x", i, pt)
+		}
+		ciphertexts = append(ciphertexts, ct)
+	}
+
+	// Sending the same plaintext again in the session gives a different
+	// ciphertext, as the history has changed.
+	if ct, _ := sender.Wrap(nil, []byte("hello")); bytes.Equal(ct, ciphertexts[0]) {
+		t.Errorf("repeated plaintext gave the same ciphertext")
+	}
+}
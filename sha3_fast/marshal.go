@@ -0,0 +1,131 @@
+package sha3_fast
+
+// This file implements the serialization of the sponges, in the format of
+// crypto/sha3 in the standard library, so that states can be exchanged with
+// it.
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	magicSHA3  = "sha\x08"
+	magicShake = "sha\x09"
+
+	// magic || rate || main state || n || sponge direction
+	marshaledSize = len(magicSHA3) + 1 + 200 + 1 + 1
+)
+
+// magic returns the identifier of the kind of sponge d is in its serialized
+// form, or "" if it has none.
+func (d *state) magic() string {
+	if d.rounds != fullRounds {
+		return ""
+	}
+	switch d.dsbyte {
+	case 0x06:
+		return magicSHA3
+	case 0x1f:
+		return magicShake
+	}
+	return ""
+}
+
+// laneBytes writes the lanes to b as the 200 bytes of the state in the
+// specification, whether they are bit-interleaved or not.
+func (d *state) laneBytes(b []byte) {
+	for i, lane := range d.a {
+		if interleavedLanes {
+			lo, hi := deinterleave(uint32(lane), uint32(lane>>32))
+			lane = uint64(hi)<<32 | uint64(lo)
+		}
+		binary.LittleEndian.PutUint64(b[8*i:], lane)
+	}
+}
+
+// setLaneBytes sets the lanes from the 200 bytes of the state in b.
+func (d *state) setLaneBytes(b []byte) {
+	for i := range d.a {
+		lane := binary.LittleEndian.Uint64(b[8*i:])
+		if interleavedLanes {
+			even, odd := interleave(uint32(lane), uint32(lane>>32))
+			lane = uint64(odd)<<32 | uint64(even)
+		}
+		d.a[i] = lane
+	}
+}
+
+// appendBinary appends the serialized state to b. Where d buffers input
+// that hasn't been xored into the lanes yet, the serialized state has it
+// xored in, and n is the number of bytes absorbed or squeezed in the current
+// block. As d permutes as soon as a block has been squeezed, n is never the
+// rate when squeezing, unlike in crypto/sha3.
+func (d *state) appendBinary(b []byte) ([]byte, error) {
+	magic := d.magic()
+	if magic == "" {
+		return nil, errors.New("sha3: can't marshal this sponge")
+	}
+	if d.partialBits != 0 {
+		return nil, errors.New("sha3: can't marshal a sponge holding a partial byte")
+	}
+	var lanes [200]byte
+	d.laneBytes(lanes[:])
+	var n int
+	if d.state == spongeAbsorbing {
+		n = len(d.buf)
+		for i, x := range d.buf {
+			lanes[i] ^= x
+		}
+	} else {
+		n = d.rate - len(d.buf)
+	}
+	b = append(b, magic...)
+	b = append(b, byte(d.rate))
+	b = append(b, lanes[:]...)
+	return append(b, byte(n), byte(d.state)), nil
+}
+
+// unmarshalBinary restores a state serialized by appendBinary, or by
+// crypto/sha3, into d, which must be the same kind of sponge.
+func (d *state) unmarshalBinary(b []byte) error {
+	if len(b) != marshaledSize {
+		return errors.New("sha3: invalid hash state")
+	}
+	magic := d.magic()
+	if magic == "" || string(b[:len(magic)]) != magic {
+		return errors.New("sha3: invalid hash state identifier")
+	}
+	b = b[len(magic):]
+	if int(b[0]) != d.rate {
+		return errors.New("sha3: invalid hash state function")
+	}
+	var lanes [200]byte
+	copy(lanes[:], b[1:])
+	n, direction := int(b[201]), spongeDirection(b[202])
+	if n > d.rate || (direction != spongeAbsorbing && direction != spongeSqueezing) {
+		return errors.New("sha3: invalid hash state")
+	}
+
+	d.state = direction
+	d.partial, d.partialBits = 0, 0
+	if direction == spongeAbsorbing {
+		// Take the bytes absorbed in the current block back out of the
+		// lanes into the buffer, which gets xored into them when full.
+		d.buf = d.storage[:n]
+		copy(d.buf, lanes[:n])
+		for i := range lanes[:n] {
+			lanes[i] = 0
+		}
+		d.setLaneBytes(lanes[:])
+		if n == d.rate {
+			d.permute()
+		}
+		return nil
+	}
+	d.setLaneBytes(lanes[:])
+	d.buf = d.storage[:d.rate]
+	copyOut(d, d.buf)
+	d.buf = d.buf[n:]
+	return nil
+}
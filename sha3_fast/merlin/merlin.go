@@ -0,0 +1,63 @@
+// Package merlin implements Merlin transcripts, as described at
+// https://merlin.cool, on the STROBE implementation of sha3_fast. A
+// transcript absorbs the labelled messages of an interactive protocol, such
+// as a zero-knowledge proof, and derives the verifier's challenges from them,
+// which makes the protocol non-interactive.
+package merlin
+
+import (
+	"encoding/binary"
+
+	sha3 "github.com/anonymouse64/sha3_arm/sha3_fast"
+)
+
+// Transcript is a Merlin transcript.
+type Transcript struct {
+	s *sha3.Strobe
+}
+
+// NewTranscript creates a transcript for the protocol named by label.
+func NewTranscript(label string) *Transcript {
+	s, err := sha3.NewStrobe("Merlin v1.0", 128)
+	if err != nil {
+		panic(err)
+	}
+	t := &Transcript{s: s}
+	t.AppendMessage([]byte("dom-sep"), []byte(label))
+	return t
+}
+
+// Clone returns a copy of the transcript in its current state, for instance
+// to derive challenges for several branches of a protocol.
+func (t *Transcript) Clone() *Transcript {
+	return &Transcript{s: t.s.Clone()}
+}
+
+// appendLabel absorbs a label and a length as a single meta-AD operation.
+func (t *Transcript) appendLabel(label []byte, n int) {
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(n))
+	t.s.Operate(sha3.StrobeAD|sha3.StrobeM, label, false)
+	t.s.Operate(sha3.StrobeAD|sha3.StrobeM, length[:], true)
+}
+
+// AppendMessage absorbs message into the transcript under label.
+func (t *Transcript) AppendMessage(label, message []byte) {
+	t.appendLabel(label, len(message))
+	t.s.AD(message)
+}
+
+// AppendUint64 absorbs x into the transcript under label, as 8 little-endian
+// bytes.
+func (t *Transcript) AppendUint64(label []byte, x uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], x)
+	t.AppendMessage(label, b[:])
+}
+
+// ChallengeBytes fills out with challenge bytes derived from the transcript
+// so far, and absorbs label and the length of out.
+func (t *Transcript) ChallengeBytes(label, out []byte) {
+	t.appendLabel(label, len(out))
+	t.s.PRF(out)
+}
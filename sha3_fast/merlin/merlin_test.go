@@ -0,0 +1,57 @@
+package merlin
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// The test vectors are those of https://github.com/gtank/merlin, the first of
+// which comes from the tests of the Rust implementation.
+
+func TestSimpleTranscript(t *testing.T) {
+	tr := NewTranscript("test protocol")
+	tr.AppendMessage([]byte("some label"), []byte("some data"))
+
+	challenge := make([]byte, 32)
+	tr.ChallengeBytes([]byte("challenge"), challenge)
+	want := "d5a21972d0d5fe320c0d263fac7fffb8145aa640af6e9bca177c03c7efcf0615"
+	if got := hex.EncodeToString(challenge); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestComplexTranscript(t *testing.T) {
+	tr := NewTranscript("test protocol")
+	tr.AppendMessage([]byte("step1"), []byte("some data"))
+
+	data := make([]byte, 1024)
+	for i := range data {
+		data[i] = 99
+	}
+
+	challenge := make([]byte, 32)
+	for i := 0; i < 32; i++ {
+		tr.ChallengeBytes([]byte("challenge"), challenge)
+		tr.AppendMessage([]byte("bigdata"), data)
+		tr.AppendMessage([]byte("challengedata"), challenge)
+	}
+
+	want := "a8c933f54fae76e3f9bea93648c1308e7dfa2152dd51674ff3ca438351cf003c"
+	if got := hex.EncodeToString(challenge); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// TestClone checks that a clone derives the same challenges as the original.
+func TestClone(t *testing.T) {
+	tr := NewTranscript("test protocol")
+	tr.AppendUint64([]byte("round"), 7)
+	clone := tr.Clone()
+
+	a, b := make([]byte, 64), make([]byte, 64)
+	tr.ChallengeBytes([]byte("challenge"), a)
+	clone.ChallengeBytes([]byte("challenge"), b)
+	if hex.EncodeToString(a) != hex.EncodeToString(b) {
+		t.Errorf("clone gave %x, want %x", b, a)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !race
+// +build !race
+
+package sha3_fast
+
+const raceEnabled = false
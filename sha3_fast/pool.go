@@ -0,0 +1,68 @@
+package sha3_fast
+
+// This file keeps pools of sponges, so that the one-shot functions and Sum
+// don't allocate. The permutation and the xorIn and copyOut functions are
+// called through variables, so the compiler can't tell that a sponge doesn't
+// outlive them, and a sponge on the stack would escape to the heap anyway.
+
+import (
+	"hash"
+	"sync"
+)
+
+// statePool holds sponges for temporary use.
+var statePool = sync.Pool{New: func() interface{} { return new(state) }}
+
+// getState returns a sponge from statePool with the given parameters, which
+// must be returned with putState when done.
+func getState(rate, outputLen int, dsbyte byte) *state {
+	d := statePool.Get().(*state)
+	d.init(rate, outputLen, dsbyte)
+	return d
+}
+
+// getStateCopy returns a copy of src from statePool, which must be returned
+// with putState when done.
+func getStateCopy(src *state) *state {
+	d := statePool.Get().(*state)
+	d.copyFrom(src)
+	return d
+}
+
+// putState clears d, so that the pool doesn't keep anything derived from
+// the data, and returns it to statePool.
+func putState(d *state) {
+	*d = state{}
+	statePool.Put(d)
+}
+
+// HashPool is a pool of SHA-3 hashes of one kind, for programs that hash
+// many short messages concurrently and don't want to allocate a hash for
+// each. It is safe for concurrent use.
+type HashPool struct {
+	pool sync.Pool
+}
+
+// NewHashPool returns a pool of the hashes created by newHash, which must be
+// one of New224, New256, New384 and New512.
+func NewHashPool(newHash func() hash.Hash) *HashPool {
+	h, ok := newHash().(*Hash)
+	if !ok {
+		panic("sha3: NewHashPool needs a SHA-3 hash of this package")
+	}
+	p := new(HashPool)
+	p.pool.New = func() interface{} { return newHash().(*Hash) }
+	p.pool.Put(h)
+	return p
+}
+
+// Get returns a hash from the pool in its initial state.
+func (p *HashPool) Get() *Hash {
+	return p.pool.Get().(*Hash)
+}
+
+// Put resets h and returns it to the pool. h must not be used afterwards.
+func (p *HashPool) Put(h *Hash) {
+	h.Reset()
+	p.pool.Put(h)
+}
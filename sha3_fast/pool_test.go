@@ -0,0 +1,130 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	xsha3 "golang.org/x/crypto/sha3"
+)
+
+func TestSumInto(t *testing.T) {
+	msg := sequentialBytes(300)
+	for _, tc := range hashConstructors {
+		h := tc.new().(*Hash)
+		h.Write(msg)
+		want := h.Sum(nil)
+		got := make([]byte, h.Size()+3)
+		h.SumInto(got)
+		if !bytes.Equal(got[:h.Size()], want) {
+			t.Errorf("%s: SumInto got %x, want %x", tc.name, got[:h.Size()], want)
+		}
+		if !bytes.Equal(got[h.Size():], []byte{0, 0, 0}) {
+			t.Errorf("%s: SumInto wrote past the digest", tc.name)
+		}
+		// SumInto leaves the hash as it was.
+		h.Write(msg)
+		std := tc.std()
+		std.Write(msg)
+		std.Write(msg)
+		if got, want := h.Sum(nil), std.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%s: after SumInto got %x, want %x", tc.name, got, want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("SumInto with a short buffer didn't panic")
+		}
+	}()
+	New256().(*Hash).SumInto(make([]byte, 31))
+}
+
+// TestPooledSums checks that the one-shot functions still agree with
+// x/crypto when they share sponges from the pool between goroutines.
+func TestPooledSums(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for n := g; n < 600; n += 37 {
+				msg := sequentialBytes(n)
+				if got, want := Sum256(msg), xsha3.Sum256(msg); got != want {
+					t.Errorf("Sum256 of %d bytes: got %x, want %x", n, got, want)
+				}
+				if got, want := Sum512(msg), xsha3.Sum512(msg); got != want {
+					t.Errorf("Sum512 of %d bytes: got %x, want %x", n, got, want)
+				}
+				got, want := make([]byte, 300), make([]byte, 300)
+				ShakeSum128(got, msg)
+				xsha3.ShakeSum128(want, msg)
+				if !bytes.Equal(got, want) {
+					t.Errorf("ShakeSum128 of %d bytes: got %x, want %x", n, got, want)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestHashPool(t *testing.T) {
+	p := NewHashPool(New384)
+	msg := []byte("pooled")
+	for i := 0; i < 3; i++ {
+		h := p.Get()
+		if h.Size() != 48 {
+			t.Fatalf("got a hash of size %d, want 48", h.Size())
+		}
+		h.Write(msg)
+		if got, want := h.Sum(nil), Sum384(msg); !bytes.Equal(got, want[:]) {
+			t.Errorf("round %d: got %x, want %x", i, got, want)
+		}
+		p.Put(h)
+	}
+}
+
+// TestSumAllocs checks that summing doesn't allocate once the pools are
+// warm.
+func TestSumAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("sync.Pool drops items under the race detector")
+	}
+	msg := sequentialBytes(1000)
+	h := New256().(*Hash)
+	h.Write(msg)
+	pool := NewHashPool(New256)
+	var digest [64]byte
+	for _, tc := range []struct {
+		name string
+		f    func()
+	}{
+		{"Sum224", func() { Sum224(msg) }},
+		{"Sum256", func() { Sum256(msg) }},
+		{"Sum384", func() { Sum384(msg) }},
+		{"Sum512", func() { Sum512(msg) }},
+		{"ShakeSum128", func() { ShakeSum128(digest[:], msg) }},
+		{"ShakeSum256", func() { ShakeSum256(digest[:], msg) }},
+		{"Hash.Sum", func() { h.Sum(digest[:0]) }},
+		{"Hash.SumInto", func() { h.SumInto(digest[:]) }},
+		{"HashPool", func() {
+			h := pool.Get()
+			h.Write(msg)
+			h.SumInto(digest[:])
+			pool.Put(h)
+		}},
+	} {
+		if allocs := testing.AllocsPerRun(100, tc.f); allocs != 0 {
+			t.Errorf("%s: %v allocations, want 0", tc.name, allocs)
+		}
+	}
+}
+
+func BenchmarkSum256Small(b *testing.B) {
+	msg := make([]byte, 64)
+	b.SetBytes(int64(len(msg)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Sum256(msg)
+	}
+}
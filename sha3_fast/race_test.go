@@ -0,0 +1,8 @@
+//go:build race
+// +build race
+
+package sha3_fast
+
+// raceEnabled reports whether the tests run with the race detector, under
+// which sync.Pool drops items on purpose and allocation counts are off.
+const raceEnabled = true
@@ -4,7 +4,7 @@
 
 package sha3_fast
 
-import "fmt"
+import "unsafe"
 
 // spongeDirection indicates the direction bytes are flowing through the sponge.
 type spongeDirection int
@@ -17,20 +17,54 @@ const (
 )
 
 const (
-	// maxRate is the maximum size of the internal buffer. SHAKE-256
-	// currently needs the largest buffer.
-	maxRate = 168
+	// maxRate is the maximum size of the internal buffer, which is the
+	// rate of a sponge with the smallest capacity NewSponge allows.
+	maxRate = 200 - 8
+
+	// fullRounds is the number of rounds of the KeccakF-1600 permutation.
+	fullRounds = 24
+
+	// laneAlignment is the alignment in bytes of the main state of the hash,
+	// which the NEON implementation needs to be 256-bit aligned.
+	laneAlignment = 32
 )
 
-func init() {
-	fmt.Println("hello from optimized SHA3 library")
+// laneStorage has room for the 25 lanes of the state at any laneAlignment
+// alignment, even where uint64s are only 4-byte aligned.
+type laneStorage [25 + laneAlignment/8]uint64
+
+// alignLanes returns a view of the lanes in storage which is aligned to
+// laneAlignment bytes.
+func alignLanes(storage *laneStorage) *[25]uint64 {
+	off := (laneAlignment - uintptr(unsafe.Pointer(storage))%laneAlignment) % laneAlignment
+	return (*[25]uint64)(unsafe.Pointer(uintptr(unsafe.Pointer(storage)) + off))
+}
+
+// isLaneAligned returns whether a is aligned to laneAlignment bytes.
+func isLaneAligned(a *[25]uint64) bool {
+	return uintptr(unsafe.Pointer(a))%laneAlignment == 0
+}
+
+// permuteAligned applies the permutation f, which needs its lanes aligned to
+// laneAlignment bytes, to a, going through an aligned copy if a isn't.
+func permuteAligned(a *[25]uint64, f func(a *[25]uint64)) {
+	if isLaneAligned(a) {
+		f(a)
+		return
+	}
+	var storage laneStorage
+	aligned := alignLanes(&storage)
+	*aligned = *a
+	f(aligned)
+	*a = *aligned
 }
 
 type state struct {
 	// Generic sponge components.
-	a    [25]uint64 // main state of the hash
-	buf  []byte     // points into storage
-	rate int        // the number of bytes of state to use
+	a      *[25]uint64 // main state of the hash, an aligned view of lanes
+	buf    []byte      // points into storage
+	rate   int         // the number of bytes of state to use
+	rounds int         // the number of rounds of the permutation
 
 	// dsbyte contains the "domain separation" bits and the first bit of
 	// the padding. Sections 6.1 and 6.2 of [1] separate the outputs of the
@@ -46,12 +80,34 @@ type state struct {
 	//      Extendable-Output Functions (May 2014)"
 	dsbyte  byte
 	storage [maxRate]byte
+	lanes   laneStorage
+
+	// partial holds the trailing bits of a message whose length isn't a
+	// whole number of bytes, in its partialBits least significant bits.
+	// They are merged with dsbyte when padding.
+	partial     byte
+	partialBits int
 
 	// Specific to SHA-3 and SHAKE.
 	outputLen int             // the default output size in bytes
 	state     spongeDirection // whether the sponge is absorbing or squeezing
 }
 
+// newState returns a sponge with the given parameters, in the absorbing
+// state, with its lanes aligned.
+func newState(rate, outputLen int, dsbyte byte) *state {
+	d := new(state)
+	d.init(rate, outputLen, dsbyte)
+	return d
+}
+
+// init sets d to a sponge with the given parameters, like newState, for
+// states that are part of another struct.
+func (d *state) init(rate, outputLen int, dsbyte byte) {
+	*d = state{rate: rate, rounds: fullRounds, outputLen: outputLen, dsbyte: dsbyte}
+	d.a = alignLanes(&d.lanes)
+}
+
 // BlockSize returns the rate of sponge underlying this hash function.
 func (d *state) BlockSize() int { return d.rate }
 
@@ -67,17 +123,50 @@ func (d *state) Reset() {
 	}
 	d.state = spongeAbsorbing
 	d.buf = d.storage[:0]
+	d.partial, d.partialBits = 0, 0
 }
 
 func (d *state) clone() *state {
-	ret := *d
-	if ret.state == spongeAbsorbing {
-		ret.buf = ret.storage[:len(ret.buf)]
+	ret := new(state)
+	ret.copyFrom(d)
+	return ret
+}
+
+// copyFrom sets d to a copy of src, without allocating, which lets callers
+// restore a precomputed state.
+func (d *state) copyFrom(src *state) {
+	*d = *src
+	// The copy's lanes may need a different offset to be aligned
+	d.a = alignLanes(&d.lanes)
+	*d.a = *src.a
+	if d.state == spongeAbsorbing {
+		d.buf = d.storage[:len(src.buf)]
 	} else {
-		ret.buf = ret.storage[d.rate-cap(d.buf) : d.rate]
+		// src.buf is a suffix of the squeezed part of src.storage, and its
+		// capacity runs to the end of src.storage, not to src.rate.
+		d.buf = d.storage[len(src.storage)-cap(src.buf) : src.rate]
+	}
+}
+
+// isStandardRate returns whether rate is that of one of the SHA-3 or SHAKE
+// instances, which are the only rates the unrolled and fused implementations
+// of absorbing handle.
+func isStandardRate(rate int) bool {
+	switch rate {
+	case 72, 104, 136, 144, 168:
+		return true
 	}
+	return false
+}
 
-	return &ret
+// permuteLanes applies the permutation to the lanes, with the sponge's
+// number of rounds.
+func (d *state) permuteLanes() {
+	if d.rounds == fullRounds {
+		keccakF1600(d.a)
+		return
+	}
+	keccakP1600(d.a, d.rounds)
 }
 
 // permute applies the KeccakF-1600 permutation. It handles
@@ -89,11 +178,11 @@ func (d *state) permute() {
 		// before applying the permutation.
 		xorIn(d, d.buf)
 		d.buf = d.storage[:0]
-		keccakF1600(&d.a)
+		d.permuteLanes()
 	case spongeSqueezing:
 		// If we're squeezing, we need to apply the permutatin before
 		// copying more output.
-		keccakF1600(&d.a)
+		d.permuteLanes()
 		d.buf = d.storage[:d.rate]
 		copyOut(d, d.buf)
 	}
@@ -105,11 +194,29 @@ func (d *state) padAndPermute(dsbyte byte) {
 	if d.buf == nil {
 		d.buf = d.storage[:0]
 	}
+	// Any trailing message bits come first, followed by the domain-separator
+	// bits, which can then spill over into a second byte.
+	delimited := uint16(dsbyte)
+	if d.partialBits > 0 {
+		delimited = uint16(d.partial) | uint16(dsbyte)<<uint(d.partialBits)
+	}
+	if delimited >= 0x100 {
+		d.buf = append(d.buf, byte(delimited))
+		if len(d.buf) == d.rate {
+			d.permute()
+		}
+		delimited >>= 8
+	}
 	// Pad with this instance's domain-separator bits. We know that there's
 	// at least one byte of space in d.buf because, if it were full,
 	// permute would have been called to empty it. dsbyte also contains the
 	// first one bit for the padding. See the comment in the state struct.
-	d.buf = append(d.buf, dsbyte)
+	d.buf = append(d.buf, byte(delimited))
+	if delimited&0x80 != 0 && len(d.buf) == d.rate {
+		// The first one bit of the padding is the very last bit of the
+		// block, so the final one bit needs a block of its own.
+		d.permute()
+	}
 	zerosStart := len(d.buf)
 	d.buf = d.storage[:d.rate]
 	for i := zerosStart; i < d.rate; i++ {
@@ -126,23 +233,37 @@ func (d *state) padAndPermute(dsbyte byte) {
 	copyOut(d, d.buf)
 }
 
+// fusable returns whether the sponge can absorb whole blocks with
+// absorbBlocks, which only handles the full permutation and standard rates.
+func (d *state) fusable() bool {
+	return absorbBlocks != nil && d.rounds == fullRounds && isStandardRate(d.rate)
+}
+
 // Write absorbs more data into the hash's state. It produces an error
 // if more data is written to the ShakeHash after writing
 func (d *state) Write(p []byte) (written int, err error) {
 	if d.state != spongeAbsorbing {
 		panic("sha3: write to sponge after read")
 	}
+	if d.partialBits != 0 {
+		panic("sha3: write to sponge after a partial byte")
+	}
 	if d.buf == nil {
 		d.buf = d.storage[:0]
 	}
 	written = len(p)
 
 	for len(p) > 0 {
-		if len(d.buf) == 0 && len(p) >= d.rate {
+		if len(d.buf) == 0 && len(p) >= 2*d.rate && d.fusable() {
+			// The fused fast path; absorb all the full blocks of input in one call.
+			n := len(p) - len(p)%d.rate
+			absorbBlocks(d.a, p[:n], d.rate)
+			p = p[n:]
+		} else if len(d.buf) == 0 && len(p) >= d.rate {
 			// The fast path; absorb a full "rate" bytes of input and apply the permutation.
 			xorIn(d, p[:d.rate])
 			p = p[d.rate:]
-			keccakF1600(&d.a)
+			d.permuteLanes()
 		} else {
 			// The slow path; buffer the input until we can fill the sponge, and then xor it in.
 			todo := d.rate - len(d.buf)
@@ -162,6 +283,22 @@ func (d *state) Write(p []byte) (written int, err error) {
 	return
 }
 
+// WriteBits absorbs the first nbits bits of p into the hash's state, for
+// messages whose length isn't a whole number of bytes. The bits of a final
+// partial byte are taken from its least significant bits, which is the
+// convention used by the Keccak team's bitwise test vectors. Once a partial
+// byte has been written no more data can be written.
+func (d *state) WriteBits(p []byte, nbits int) {
+	if nbits < 0 || nbits > 8*len(p) {
+		panic("sha3: invalid number of bits")
+	}
+	d.Write(p[:nbits/8])
+	if rem := nbits % 8; rem != 0 {
+		d.partial = p[nbits/8] & (1<<uint(rem) - 1)
+		d.partialBits = rem
+	}
+}
+
 // Read squeezes an arbitrary number of bytes from the sponge.
 func (d *state) Read(out []byte) (n int, err error) {
 	// If we're still absorbing, pad and apply the permutation.
@@ -189,10 +326,20 @@ func (d *state) Read(out []byte) (n int, err error) {
 // Sum applies padding to the hash state and then squeezes out the desired
 // number of output bytes.
 func (d *state) Sum(in []byte) []byte {
-	// Make a copy of the original hash so that caller can keep writing
-	// and summing.
-	dup := d.clone()
-	hash := make([]byte, dup.outputLen)
-	dup.Read(hash)
-	return append(in, hash...)
+	var hash [maxRate]byte
+	n := d.outputLen
+	if n > len(hash) {
+		// Only sponges from NewSponge can have such long outputs.
+		return append(in, d.sum(make([]byte, n))...)
+	}
+	return append(in, d.sum(hash[:n])...)
+}
+
+// sum squeezes len(out) bytes of output into out from a copy of the sponge,
+// so that the caller can keep writing and summing, and returns out.
+func (d *state) sum(out []byte) []byte {
+	dup := getStateCopy(d)
+	dup.Read(out)
+	putState(dup)
+	return out
 }
@@ -7,8 +7,16 @@ package sha3_fast
 // Tests include all the ShortMsgKATs provided by the Keccak team at
 // https://github.com/gvanas/KeccakCodePackage
 //
-// They only include the zero-bit case of the bitwise testvectors
-// published by NIST in the draft of FIPS-202.
+// Those only include the messages whose lengths are a multiple of 8 bits.
+// For the other lengths, TestNISTBitExamples has the digests of the 5, 30,
+// 1605 and 1630 bit messages of NIST's "SHA-3 examples with intermediate
+// values", which are the only official vectors in this tree. The bitwise
+// ShortMsgKATs of the Keccak team, covering every length up to 2047 bits,
+// aren't vendored yet. keccakBitKats.json.deflate holds about 80 lengths per
+// function that were generated with our own bit-oriented implementation of
+// FIPS-202 rather than taken from an official source, so it only shows
+// agreement with that implementation, which itself agrees with the NIST
+// examples.
 
 import (
 	"bytes"
@@ -20,19 +28,23 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"unsafe"
 )
 
 const (
 	testString  = "brekeccakkeccak koax koax"
 	katFilename = "testdata/keccakKats.json.deflate"
+	// bitKatFilename holds KATs for message lengths that aren't a whole
+	// number of bytes, in the same format as katFilename
+	bitKatFilename = "testdata/keccakBitKats.json.deflate"
 )
 
 // Internal-use instances of SHAKE used to test against KATs.
 func newHashShake128() hash.Hash {
-	return &state{rate: 168, dsbyte: 0x1f, outputLen: 512}
+	return newState(168, 512, 0x1f)
 }
 func newHashShake256() hash.Hash {
-	return &state{rate: 136, dsbyte: 0x1f, outputLen: 512}
+	return newState(136, 512, 0x1f)
 }
 
 // testDigests contains functions returning hash.Hash instances
@@ -73,57 +85,148 @@ type KeccakKats struct {
 }
 
 func testUnalignedAndGeneric(t *testing.T, testf func(impl string)) {
-	xorInOrig, copyOutOrig := xorIn, copyOut
-	xorIn, copyOut = xorInGeneric, copyOutGeneric
+	xorInOrig, copyOutOrig, keccakF1600Orig := xorIn, copyOut, keccakF1600
+	absorbBlocksOrig, interleavedOrig := absorbBlocks, interleavedLanes
+	absorbBlocks = nil
+	// The byte order implementations need a permutation on ordinary lanes
+	if interleavedLanes {
+		keccakF1600 = keccakF1600Generic
+	}
+	xorIn, copyOut, interleavedLanes = xorInGeneric, copyOutGeneric, false
 	testf("generic")
 	if xorImplementationUnaligned != "generic" {
 		xorIn, copyOut = xorInUnaligned, copyOutUnaligned
 		testf("unaligned")
 	}
-	xorIn, copyOut = xorInOrig, copyOutOrig
+	// Keep the platform's own interleaved permutation if it has one
+	xorIn, copyOut, keccakF1600 = xorInInterleaved, copyOutInterleaved, keccakF1600Interleaved
+	if interleavedOrig {
+		keccakF1600 = keccakF1600Orig
+	}
+	interleavedLanes = true
+	testf("interleaved")
+	xorIn, copyOut, keccakF1600 = xorInOrig, copyOutOrig, keccakF1600Orig
+	interleavedLanes = interleavedOrig
+	if absorbBlocksOrig != nil {
+		absorbBlocks = absorbBlocksOrig
+		testf("fused")
+	}
 }
 
 // TestKeccakKats tests the SHA-3 and Shake implementations against all the
 // ShortMsgKATs from https://github.com/gvanas/KeccakCodePackage
 // (The testvectors are stored in keccakKats.json.deflate due to their length.)
+// and the bitwise KATs in keccakBitKats.json.deflate
 func TestKeccakKats(t *testing.T) {
 	testUnalignedAndGeneric(t, func(impl string) {
-		// Read the KATs.
-		deflated, err := os.Open(katFilename)
-		if err != nil {
-			t.Errorf("error opening %s: %s", katFilename, err)
-		}
-		file := flate.NewReader(deflated)
-		dec := json.NewDecoder(file)
-		var katSet KeccakKats
-		err = dec.Decode(&katSet)
-		if err != nil {
-			t.Errorf("error decoding KATs: %s", err)
-		}
+		for _, filename := range []string{katFilename, bitKatFilename} {
+			// Read the KATs.
+			deflated, err := os.Open(filename)
+			if err != nil {
+				t.Fatalf("error opening %s: %s", filename, err)
+			}
+			file := flate.NewReader(deflated)
+			dec := json.NewDecoder(file)
+			var katSet KeccakKats
+			err = dec.Decode(&katSet)
+			deflated.Close()
+			if err != nil {
+				t.Fatalf("error decoding KATs: %s", err)
+			}
 
-		// Do the KATs.
-		for functionName, kats := range katSet.Kats {
-			d := testDigests[functionName]()
-			for _, kat := range kats {
-				d.Reset()
-				in, err := hex.DecodeString(kat.Message)
-				if err != nil {
-					t.Errorf("error decoding KAT: %s", err)
-				}
-				d.Write(in[:kat.Length/8])
-				got := strings.ToUpper(hex.EncodeToString(d.Sum(nil)))
-				if got != kat.Digest {
-					t.Errorf("function=%s, implementation=%s, length=%d\nmessage:\n  %s\ngot:\n  %s\nwanted:\n %s",
-						functionName, impl, kat.Length, kat.Message, got, kat.Digest)
-					t.Logf("wanted %+v", kat)
-					t.FailNow()
+			// Do the KATs.
+			for functionName, kats := range katSet.Kats {
+				d := testDigests[functionName]()
+				for _, kat := range kats {
+					d.Reset()
+					in, err := hex.DecodeString(kat.Message)
+					if err != nil {
+						t.Errorf("error decoding KAT: %s", err)
+					}
+					d.(BitWriter).WriteBits(in, int(kat.Length))
+					got := strings.ToUpper(hex.EncodeToString(d.Sum(nil)))
+					if got != kat.Digest {
+						t.Errorf("function=%s, implementation=%s, length=%d\nmessage:\n  %s\ngot:\n  %s\nwanted:\n %s",
+							functionName, impl, kat.Length, kat.Message, got, kat.Digest)
+						t.Logf("wanted %+v", kat)
+						t.FailNow()
+					}
+					continue
 				}
-				continue
 			}
 		}
 	})
 }
 
+// nistBitExamples are messages from NIST's "SHA-3 examples with intermediate
+// values" (csrc.nist.gov, Examples with Intermediate Values) whose lengths
+// aren't a multiple of 8 bits, with their digests. The bits are taken from
+// the least significant end of the last byte, so the 5-bit message 11001 is
+// 0x13.
+var nistBitExamples = []struct {
+	alg    string
+	msg    []byte
+	nbits  int
+	digest string
+}{
+	{"SHA3-224", []byte{0x13}, 5, "ffbad5da96bad71789330206dc6768ecaeb1b32dca6b3301489674ab"},
+	{"SHA3-224", []byte{0x53, 0x58, 0x7b, 0x19}, 30, "d666a514cc9dba25ac1ba69ed3930460deaac9851b5f0baab007df3b"},
+	{"SHA3-256", []byte{0x13}, 5, "7b0047cf5a456882363cbf0fb05322cf65f4b7059a46365e830132e3b5d957af"},
+	{"SHA3-256", []byte{0x53, 0x58, 0x7b, 0x19}, 30, "c8242fef409e5ae9d1f1c857ae4dc624b92b19809f62aa8c07411c54a078b1d0"},
+	{"SHA3-256", append(bytes.Repeat([]byte{0xa3}, 200), 0x03), 1605, "81ee769bed0950862b1ddded2e84aaa6ab7bfdd3ceaa471be31163d40336363c"},
+	{"SHA3-256", append(bytes.Repeat([]byte{0xa3}, 203), 0x23), 1630, "52860aa301214c610d922a6b6cab981ccd06012e54ef689d744021e738b9ed20"},
+	{"SHA3-384", []byte{0x13}, 5, "737c9b491885e9bf7428e792741a7bf8dca9653471c3e148473f2c236b6a0a6455eb1dce9f779b4b6b237fef171b1c64"},
+	{"SHA3-512", []byte{0x13}, 5, "a13e01494114c09800622a70288c432121ce70039d753cadd2e006e4d961cb27544c1481e5814bdceb53be6733d5e099795e5e81918addb058e22a9f24883f37"},
+	{"SHAKE128", []byte{0x13}, 5, "2e0abfba83e6720bfbc225ff6b7ab9ffce58ba027ee3d898764fef287ddeccca"},
+}
+
+func TestNISTBitExamples(t *testing.T) {
+	testUnalignedAndGeneric(t, func(impl string) {
+		for _, ex := range nistBitExamples {
+			var d *state
+			if newShake, ok := testShakes[ex.alg]; ok {
+				d = newShake().(*state)
+			} else {
+				d = &testDigests[ex.alg]().(*Hash).d
+			}
+			d.WriteBits(ex.msg, ex.nbits)
+			got := make([]byte, len(ex.digest)/2)
+			d.Read(got)
+			if hex.EncodeToString(got) != ex.digest {
+				t.Errorf("%s (%s) of %d bits: got %x, want %s", ex.alg, impl, ex.nbits, got, ex.digest)
+			}
+		}
+	})
+}
+
+// TestWriteBitsBytes checks that writing whole bytes with WriteBits is the
+// same as writing them with Write.
+func TestWriteBitsBytes(t *testing.T) {
+	buf := sequentialBytes(300)
+	for alg, df := range testDigests {
+		d := df()
+		d.Write(buf)
+		want := d.Sum(nil)
+		d.Reset()
+		d.(BitWriter).WriteBits(buf, 8*len(buf))
+		if got := d.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%s: WriteBits of whole bytes gave %x, want %x", alg, got, want)
+		}
+	}
+}
+
+// TestWriteAfterPartialByte checks that writing after a partial byte panics.
+func TestWriteAfterPartialByte(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("writing after a partial byte didn't panic")
+		}
+	}()
+	d := New256()
+	d.(BitWriter).WriteBits([]byte{0x13}, 5)
+	d.Write([]byte{0})
+}
+
 // TestUnalignedWrite tests that writing data in an arbitrary pattern with
 // small input buffers.
 func testUnalignedWrite(t *testing.T) {
@@ -214,6 +317,60 @@ func TestSqueezing(t *testing.T) {
 	})
 }
 
+// TestStateAlignment checks that the lanes of the state stay aligned for the
+// NEON implementation through the constructors, clones and resets.
+func TestStateAlignment(t *testing.T) {
+	for _, alg := range fuzzAlgs {
+		d := alg.newFast()
+		if !isLaneAligned(d.a) {
+			t.Errorf("%s: new state has misaligned lanes at %p", alg.name, d.a)
+		}
+		d.Write(sequentialBytes(1000))
+		// Clone while absorbing, and squeezing through Sum and Read
+		clones := []*state{d.clone()}
+		d.Sum(nil)
+		d.Read(make([]byte, 1))
+		clones = append(clones, d.clone())
+		for i, c := range clones {
+			if !isLaneAligned(c.a) {
+				t.Errorf("%s: clone %d has misaligned lanes at %p", alg.name, i, c.a)
+			}
+			if c.a == d.a {
+				t.Errorf("%s: clone %d shares its lanes with the original", alg.name, i)
+			}
+		}
+		d.Reset()
+		if !isLaneAligned(d.a) {
+			t.Errorf("%s: reset state has misaligned lanes at %p", alg.name, d.a)
+		}
+	}
+}
+
+// TestPermuteAligned checks that lanes which aren't aligned are permuted
+// through an aligned copy.
+func TestPermuteAligned(t *testing.T) {
+	var storage laneStorage
+	// uint64s may only be 4-byte aligned, so step through every offset
+	for off := uintptr(0); off < laneAlignment; off += 4 {
+		a := (*[25]uint64)(unsafe.Pointer(uintptr(unsafe.Pointer(&storage[0])) + off))
+		var want [25]uint64
+		for i := range a {
+			a[i] = uint64(i) * 0x0123456789abcdef
+			want[i] = a[i]
+		}
+		keccakF1600Generic(&want)
+		permuteAligned(a, func(b *[25]uint64) {
+			if !isLaneAligned(b) {
+				t.Fatalf("offset %d: permutation called with misaligned lanes at %p", off, b)
+			}
+			keccakF1600Generic(b)
+		})
+		if *a != want {
+			t.Errorf("offset %d: got %x, want %x", off, *a, want)
+		}
+	}
+}
+
 // sequentialBytes produces a buffer of size consecutive bytes 0x00, 0x01, ..., used for testing.
 func sequentialBytes(size int) []byte {
 	result := make([]byte, size)
@@ -233,6 +390,25 @@ func BenchmarkPermutationFunction(b *testing.B) {
 	}
 }
 
+// BenchmarkPermutationGeneric and BenchmarkPermutationInterleaved compare the
+// two portable implementations, the interleaved one is only expected to be
+// faster on 32-bit platforms.
+func BenchmarkPermutationGeneric(b *testing.B) {
+	b.SetBytes(int64(200))
+	var lanes [25]uint64
+	for i := 0; i < b.N; i++ {
+		keccakF1600Generic(&lanes)
+	}
+}
+
+func BenchmarkPermutationInterleaved(b *testing.B) {
+	b.SetBytes(int64(200))
+	var lanes [25]uint64
+	for i := 0; i < b.N; i++ {
+		keccakF1600Interleaved(&lanes)
+	}
+}
+
 // benchmarkHash tests the speed to hash num buffers of buflen each.
 func benchmarkHash(b *testing.B, h hash.Hash, size, num int) {
 	b.StopTimer()
@@ -31,6 +31,15 @@ type ShakeHash interface {
 	Reset()
 }
 
+// BitWriter is implemented by the SHA-3 and SHAKE hashes to absorb messages
+// whose length in bits isn't a multiple of 8.
+type BitWriter interface {
+	// WriteBits absorbs the first nbits bits of p, taking the bits of a
+	// final partial byte from its least significant bits. It panics if
+	// input is written to the hash after a partial byte.
+	WriteBits(p []byte, nbits int)
+}
+
 func (d *state) Clone() ShakeHash {
 	return d.clone()
 }
@@ -38,23 +47,27 @@ func (d *state) Clone() ShakeHash {
 // NewShake128 creates a new SHAKE128 variable-output-length ShakeHash.
 // Its generic security strength is 128 bits against all attacks if at
 // least 32 bytes of its output are used.
-func NewShake128() ShakeHash { return &state{rate: 168, dsbyte: 0x1f} }
+func NewShake128() ShakeHash { return newState(168, 0, 0x1f) }
 
 // NewShake256 creates a new SHAKE256 variable-output-length ShakeHash.
 // Its generic security strength is 256 bits against all attacks if
 // at least 64 bytes of its output are used.
-func NewShake256() ShakeHash { return &state{rate: 136, dsbyte: 0x1f} }
+func NewShake256() ShakeHash { return newState(136, 0, 0x1f) }
 
-// ShakeSum128 writes an arbitrary-length digest of data into hash.
+// ShakeSum128 writes an arbitrary-length digest of data into hash. It
+// doesn't allocate once the pool of sponges is warm.
 func ShakeSum128(hash, data []byte) {
-	h := NewShake128()
-	h.Write(data)
-	h.Read(hash)
+	d := getState(168, 0, 0x1f)
+	d.Write(data)
+	d.Read(hash)
+	putState(d)
 }
 
-// ShakeSum256 writes an arbitrary-length digest of data into hash.
+// ShakeSum256 writes an arbitrary-length digest of data into hash. It
+// doesn't allocate once the pool of sponges is warm.
 func ShakeSum256(hash, data []byte) {
-	h := NewShake256()
-	h.Write(data)
-	h.Read(hash)
+	d := getState(136, 0, 0x1f)
+	d.Write(data)
+	d.Read(hash)
+	putState(d)
 }
@@ -0,0 +1,125 @@
+package sha3_fast
+
+// This file implements random access to the output of SHAKE and the other
+// sponges of this package.
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// shakeCheckpointInterval is the number of blocks of output between the
+// checkpoints of a ShakeReader. They cost 200 bytes each, or about 1% of the
+// output of SHAKE256 they span.
+const shakeCheckpointInterval = 128
+
+// ShakeReader gives random access to the output of a ShakeHash, implementing
+// io.Reader, io.ReaderAt and io.Seeker. The output has no end, so reads
+// always fill their buffer.
+//
+// Each block of output is the permutation of the previous one, so reaching an
+// offset takes a permutation per block from the closest block before it
+// whose state is known. The reader keeps the state of every 128th block it
+// goes through, as well as the current one, so reading again from anywhere
+// before the furthest offset read so far takes at most 127 permutations.
+type ShakeReader struct {
+	mu          sync.Mutex
+	d           *state // the squeezing sponge, whose lanes hold the current block
+	block       int64  // the index of the current block in the output
+	checkpoints [][25]uint64
+	off         int64 // the offset of Read
+	buf         [maxRate]byte
+}
+
+// NewShakeReader returns a ShakeReader of the output of h, which must be one
+// of the ShakeHashes of this package, such as NewShake256 or NewSponge
+// return, and from which no output must have been read. h itself is left
+// unchanged.
+func NewShakeReader(h ShakeHash) (*ShakeReader, error) {
+	src, ok := h.(*state)
+	if !ok {
+		return nil, errors.New("sha3: ShakeReader needs a sponge of this package")
+	}
+	if src.state != spongeAbsorbing {
+		return nil, errors.New("sha3: ShakeReader needs a sponge whose output hasn't been read")
+	}
+	d := src.clone()
+	d.padAndPermute(d.dsbyte)
+	return &ShakeReader{d: d, checkpoints: [][25]uint64{*d.a}}, nil
+}
+
+// seekBlock sets the lanes to those of the block with index block, from the
+// current block or the last checkpoint before it, whichever is closest.
+func (r *ShakeReader) seekBlock(block int64) {
+	c := block / shakeCheckpointInterval
+	if last := int64(len(r.checkpoints) - 1); c > last {
+		c = last
+	}
+	if block < r.block || c*shakeCheckpointInterval > r.block {
+		*r.d.a = r.checkpoints[c]
+		r.block = c * shakeCheckpointInterval
+	}
+	for r.block < block {
+		r.d.permuteLanes()
+		r.block++
+		if r.block == int64(len(r.checkpoints))*shakeCheckpointInterval {
+			r.checkpoints = append(r.checkpoints, *r.d.a)
+		}
+	}
+}
+
+// ReadAt fills p with the output starting at offset off. It can be called
+// concurrently.
+func (r *ShakeReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("sha3: negative ShakeReader offset")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := len(p)
+	rate := int64(r.d.rate)
+	for len(p) > 0 {
+		r.seekBlock(off / rate)
+		start := int(off % rate)
+		if start == 0 && len(p) >= r.d.rate {
+			copyOut(r.d, p[:r.d.rate])
+			p, off = p[r.d.rate:], off+rate
+			continue
+		}
+		copyOut(r.d, r.buf[:r.d.rate])
+		copied := copy(p, r.buf[start:r.d.rate])
+		p, off = p[copied:], off+int64(copied)
+	}
+	return n, nil
+}
+
+// Read fills p with the output from the current offset, and moves it past
+// them.
+func (r *ShakeReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	off := r.off
+	r.off += int64(len(p))
+	r.mu.Unlock()
+	return r.ReadAt(p, off)
+}
+
+// Seek sets the offset of the next Read, relative to the start of the output
+// for io.SeekStart or to the current offset for io.SeekCurrent. The output
+// has no end, so io.SeekEnd is an error.
+func (r *ShakeReader) Seek(offset int64, whence int) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	default:
+		return 0, errors.New("sha3: invalid whence for ShakeReader")
+	}
+	if offset < 0 {
+		return 0, errors.New("sha3: negative ShakeReader offset")
+	}
+	r.off = offset
+	return offset, nil
+}
@@ -0,0 +1,167 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+
+	xsha3 "golang.org/x/crypto/sha3"
+)
+
+func mustNewShakeReader(t *testing.T, h ShakeHash) *ShakeReader {
+	r, err := NewShakeReader(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// TestShakeReaderAt reads at offsets in and around the first checkpoints,
+// jumping back and forth between them.
+func TestShakeReaderAt(t *testing.T) {
+	msg := []byte("fixture seed")
+	span := 3*shakeCheckpointInterval*168 + 1000
+	for _, tc := range []struct {
+		name string
+		new  func() ShakeHash
+		xnew func() xsha3.ShakeHash
+		rate int64
+	}{
+		{"SHAKE128", NewShake128, xsha3.NewShake128, 168},
+		{"SHAKE256", NewShake256, xsha3.NewShake256, 136},
+	} {
+		ref := tc.xnew()
+		ref.Write(msg)
+		want := make([]byte, span)
+		ref.Read(want)
+
+		interval := tc.rate * shakeCheckpointInterval
+		offsets := []int64{
+			0, 1, tc.rate - 1, tc.rate, 2*interval + 5, interval - 1, interval,
+			3 * interval, 17, 2*interval - 300, 2*interval + tc.rate*3, interval + 1,
+		}
+		testUnalignedAndGeneric(t, func(impl string) {
+			h := tc.new()
+			h.Write(msg)
+			r := mustNewShakeReader(t, h)
+			for _, off := range offsets {
+				for _, n := range []int{1, 100, int(tc.rate), 3*int(tc.rate) + 7} {
+					got := make([]byte, n)
+					if read, err := r.ReadAt(got, off); read != n || err != nil {
+						t.Fatalf("%s (%s): ReadAt(%d bytes, %d) = %d, %v", tc.name, impl, n, off, read, err)
+					}
+					if !bytes.Equal(got, want[off:off+int64(n)]) {
+						t.Errorf("%s (%s): at %d got %x, want %x", tc.name, impl, off, got, want[off:off+int64(n)])
+					}
+				}
+			}
+			if len(r.checkpoints) != 4 {
+				t.Errorf("%s (%s): got %d checkpoints, want 4", tc.name, impl, len(r.checkpoints))
+			}
+
+			// h is left as it was.
+			got := make([]byte, 100)
+			h.Read(got)
+			if !bytes.Equal(got, want[:100]) {
+				t.Errorf("%s (%s): NewShakeReader changed the hash", tc.name, impl)
+			}
+		})
+	}
+}
+
+func TestShakeReaderSeek(t *testing.T) {
+	h := NewShake256()
+	h.Write([]byte("seek"))
+	want := make([]byte, 5000)
+	h.Clone().Read(want)
+
+	r := mustNewShakeReader(t, h)
+	got := make([]byte, 300)
+	for _, seek := range []struct {
+		offset int64
+		whence int
+		pos    int64
+	}{
+		{0, io.SeekCurrent, 0},
+		{4000, io.SeekStart, 4000},
+		{-3000, io.SeekCurrent, 1300},
+		{100, io.SeekCurrent, 1700},
+	} {
+		if pos, err := r.Seek(seek.offset, seek.whence); pos != seek.pos || err != nil {
+			t.Fatalf("Seek(%d, %d) = %d, %v, want %d", seek.offset, seek.whence, pos, err, seek.pos)
+		}
+		io.ReadFull(r, got)
+		if !bytes.Equal(got, want[seek.pos:seek.pos+300]) {
+			t.Errorf("at %d got %x, want %x", seek.pos, got, want[seek.pos:seek.pos+300])
+		}
+	}
+	if _, err := r.Seek(0, io.SeekEnd); err == nil {
+		t.Errorf("seeking from the end succeeded")
+	}
+	if _, err := r.Seek(-1, io.SeekStart); err == nil {
+		t.Errorf("seeking to a negative offset succeeded")
+	}
+	if _, err := r.ReadAt(got, -1); err == nil {
+		t.Errorf("reading at a negative offset succeeded")
+	}
+}
+
+// TestShakeReaderConcurrent checks that concurrent ReadAt calls get the
+// right output.
+func TestShakeReaderConcurrent(t *testing.T) {
+	h := NewShake256()
+	want := make([]byte, 1<<20)
+	h.Clone().Read(want)
+	r := mustNewShakeReader(t, h)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got := make([]byte, 1000)
+			for off := int64(len(want) - 1000 - i*1000); off >= 0; off -= 50000 {
+				r.ReadAt(got, off)
+				if !bytes.Equal(got, want[off:off+1000]) {
+					t.Errorf("goroutine %d: wrong output at %d", i, off)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestShakeReaderInvalid(t *testing.T) {
+	h := NewShake128()
+	h.Read(make([]byte, 1))
+	if _, err := NewShakeReader(h); err == nil {
+		t.Errorf("NewShakeReader accepted a hash whose output was read")
+	}
+	small, err := NewSmallSponge(800, 64, 0x1f, KeccakF800Rounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewShakeReader(small); err == nil {
+		t.Errorf("NewShakeReader accepted a Keccak-p[800] sponge")
+	}
+}
+
+// BenchmarkShakeReaderAt measures reading 64 bytes at random offsets in the
+// first 16 MiB of SHAKE256 output, once the checkpoints are in place.
+func BenchmarkShakeReaderAt(b *testing.B) {
+	r, err := NewShakeReader(NewShake256())
+	if err != nil {
+		b.Fatal(err)
+	}
+	const span = 16 << 20
+	out := make([]byte, 64)
+	r.ReadAt(out, span)
+	b.ResetTimer()
+	off := int64(12345)
+	for i := 0; i < b.N; i++ {
+		off = (off*1103515245 + 12345) % span
+		r.ReadAt(out, off)
+	}
+}
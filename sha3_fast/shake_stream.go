@@ -0,0 +1,136 @@
+package sha3_fast
+
+// This file implements a stream cipher whose keystream is the output of
+// SHAKE256 on the key and the nonce.
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// shakeStreamDomain is absorbed before the key and nonce, to separate the
+// keystream from other uses of SHAKE256 on the same inputs.
+const shakeStreamDomain = "sha3_fast SHAKE256 stream cipher v1"
+
+// MinShakeStreamKeySize is the minimum size in bytes of the key of a
+// ShakeStream.
+const MinShakeStreamKeySize = 16
+
+// ShakeStream is a stream cipher whose keystream is SHAKE256 of a domain
+// separation string, the key and the nonce, each of the last two preceded by
+// its length as 8 little-endian bytes. It implements cipher.Stream and
+// io.Seeker, seeking in the keystream.
+//
+// Like any stream cipher it doesn't authenticate the data, and a key and
+// nonce pair must never be used for two different messages.
+type ShakeStream struct {
+	d     *state        // the squeezing sponge, whose lanes hold the current block
+	first [25]uint64    // the lanes of the first block, to seek backwards from
+	block int64         // the index of the current block in the keystream
+	pos   int           // the offset in the current block of the next byte
+	ks    [maxRate]byte // the current block of keystream, when pos > 0
+}
+
+// NewShakeStream returns a ShakeStream keyed with key and nonce, positioned
+// at the start of the keystream. The key must be at least
+// MinShakeStreamKeySize bytes long; any nonce length is accepted.
+func NewShakeStream(key, nonce []byte) (*ShakeStream, error) {
+	if len(key) < MinShakeStreamKeySize {
+		return nil, errors.New("sha3: SHAKE stream key too short")
+	}
+	d := newState(136, 0, 0x1f)
+	var length [8]byte
+	d.Write([]byte(shakeStreamDomain))
+	binary.LittleEndian.PutUint64(length[:], uint64(len(key)))
+	d.Write(length[:])
+	d.Write(key)
+	binary.LittleEndian.PutUint64(length[:], uint64(len(nonce)))
+	d.Write(length[:])
+	d.Write(nonce)
+	d.padAndPermute(d.dsbyte)
+	return &ShakeStream{d: d, first: *d.a}, nil
+}
+
+// xorWords xors src into dst, a word at a time. Their length must be a
+// multiple of 8.
+func xorWords(dst, src []byte) {
+	for ; len(src) >= 8; dst, src = dst[8:], src[8:] {
+		binary.LittleEndian.PutUint64(dst, binary.LittleEndian.Uint64(dst)^binary.LittleEndian.Uint64(src))
+	}
+}
+
+// next moves on to the next block of keystream.
+func (s *ShakeStream) next() {
+	s.d.permuteLanes()
+	s.block++
+	s.pos = 0
+}
+
+// XORKeyStream xors each byte in src with a byte of keystream and writes it
+// to dst, which must overlap src entirely or not at all. Whole blocks of
+// keystream are squeezed straight into dst when it doesn't overlap src.
+func (s *ShakeStream) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("sha3: output smaller than input")
+	}
+	rate := s.d.rate
+	inPlace := len(src) > 0 && &dst[0] == &src[0]
+	for len(src) > 0 {
+		if s.pos == 0 && len(src) >= rate && !inPlace {
+			out := dst[:rate]
+			copyOut(s.d, out)
+			xorWords(out, src[:rate])
+			dst, src = dst[rate:], src[rate:]
+			s.next()
+			continue
+		}
+		if s.pos == 0 {
+			copyOut(s.d, s.ks[:rate])
+		}
+		ks := s.ks[s.pos:rate]
+		if len(ks) > len(src) {
+			ks = ks[:len(src)]
+		}
+		for i, b := range ks {
+			dst[i] = src[i] ^ b
+		}
+		dst, src = dst[len(ks):], src[len(ks):]
+		s.pos += len(ks)
+		if s.pos == rate {
+			s.next()
+		}
+	}
+}
+
+// Seek sets the position in the keystream of the next byte xored by
+// XORKeyStream, relative to the start of the keystream for io.SeekStart or
+// to the current position for io.SeekCurrent. The keystream has no end, so
+// io.SeekEnd is an error. Seeking takes a permutation per block of keystream
+// skipped, counting from the start of the keystream when seeking backwards.
+func (s *ShakeStream) Seek(offset int64, whence int) (int64, error) {
+	rate := int64(s.d.rate)
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.block*rate + int64(s.pos)
+	default:
+		return 0, errors.New("sha3: invalid whence for SHAKE stream")
+	}
+	if offset < 0 {
+		return 0, errors.New("sha3: negative SHAKE stream position")
+	}
+	block := offset / rate
+	if block < s.block {
+		*s.d.a = s.first
+		s.block = 0
+	}
+	for s.block < block {
+		s.next()
+	}
+	s.pos = int(offset % rate)
+	if s.pos > 0 {
+		copyOut(s.d, s.ks[:rate])
+	}
+	return offset, nil
+}
@@ -0,0 +1,146 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	xsha3 "golang.org/x/crypto/sha3"
+)
+
+var _ cipher.Stream = (*ShakeStream)(nil)
+
+// referenceKeystream computes n bytes of the keystream of a ShakeStream with
+// golang.org/x/crypto/sha3.
+func referenceKeystream(key, nonce []byte, n int) []byte {
+	h := xsha3.NewShake256()
+	var length [8]byte
+	h.Write([]byte(shakeStreamDomain))
+	binary.LittleEndian.PutUint64(length[:], uint64(len(key)))
+	h.Write(length[:])
+	h.Write(key)
+	binary.LittleEndian.PutUint64(length[:], uint64(len(nonce)))
+	h.Write(length[:])
+	h.Write(nonce)
+	out := make([]byte, n)
+	h.Read(out)
+	return out
+}
+
+func mustNewShakeStream(t *testing.T, key, nonce []byte) *ShakeStream {
+	s, err := NewShakeStream(key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// TestShakeStream checks the keystream, xored in chunks of various sizes both
+// in place and into a separate buffer.
+func TestShakeStream(t *testing.T) {
+	key, nonce := sequentialBytes(32), []byte("nonce")
+	msg := sequentialBytes(1000)
+	want := referenceKeystream(key, nonce, len(msg))
+	for i := range want {
+		want[i] ^= msg[i]
+	}
+	testUnalignedAndGeneric(t, func(impl string) {
+		for _, chunk := range []int{1, 7, 135, 136, 137, 300, 1000} {
+			for _, inPlace := range []bool{false, true} {
+				s := mustNewShakeStream(t, key, nonce)
+				got := make([]byte, len(msg))
+				src := msg
+				if inPlace {
+					copy(got, msg)
+					src = got
+				}
+				for i := 0; i < len(msg); i += chunk {
+					end := i + chunk
+					if end > len(msg) {
+						end = len(msg)
+					}
+					s.XORKeyStream(got[i:end], src[i:end])
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("%s, chunks of %d, in place %v: got %x, want %x", impl, chunk, inPlace, got, want)
+				}
+			}
+		}
+	})
+}
+
+// TestShakeStreamDomains checks that the key and nonce are separated.
+func TestShakeStreamDomains(t *testing.T) {
+	keystream := func(key, nonce []byte) []byte {
+		out := make([]byte, 64)
+		mustNewShakeStream(t, key, nonce).XORKeyStream(out, out)
+		return out
+	}
+	key := sequentialBytes(17)
+	if bytes.Equal(keystream(key[:16], key[16:]), keystream(key, nil)) {
+		t.Errorf("moving a byte from the key to the nonce doesn't change the keystream")
+	}
+	if bytes.Equal(keystream(key, []byte{0}), keystream(key, []byte{1})) {
+		t.Errorf("the nonce doesn't change the keystream")
+	}
+	if _, err := NewShakeStream(key[:MinShakeStreamKeySize-1], nil); err == nil {
+		t.Errorf("NewShakeStream accepted a short key")
+	}
+}
+
+func TestShakeStreamSeek(t *testing.T) {
+	key, nonce := sequentialBytes(32), []byte("nonce")
+	want := referenceKeystream(key, nonce, 2000)
+	testUnalignedAndGeneric(t, func(impl string) {
+		s := mustNewShakeStream(t, key, nonce)
+		pos := int64(0)
+		for _, seek := range []struct {
+			offset int64
+			whence int
+		}{
+			{1500, io.SeekStart},
+			{-1000, io.SeekCurrent},
+			{136, io.SeekStart},
+			{0, io.SeekCurrent},
+			{271, io.SeekCurrent},
+			{0, io.SeekStart},
+			{1, io.SeekCurrent},
+		} {
+			expected := seek.offset
+			if seek.whence == io.SeekCurrent {
+				expected += pos
+			}
+			got, err := s.Seek(seek.offset, seek.whence)
+			if err != nil || got != expected {
+				t.Fatalf("%s: Seek(%d, %d) = %d, %v, want %d", impl, seek.offset, seek.whence, got, err, expected)
+			}
+			out := make([]byte, 200)
+			s.XORKeyStream(out, out)
+			if !bytes.Equal(out, want[expected:expected+200]) {
+				t.Errorf("%s: after seeking to %d got %x, want %x", impl, expected, out, want[expected:expected+200])
+			}
+			pos = expected + 200
+		}
+
+		if _, err := s.Seek(0, io.SeekEnd); err == nil {
+			t.Errorf("%s: seeking from the end succeeded", impl)
+		}
+		if _, err := s.Seek(-1, io.SeekStart); err == nil {
+			t.Errorf("%s: seeking to a negative position succeeded", impl)
+		}
+	})
+}
+
+func BenchmarkShakeStream(b *testing.B) {
+	s, err := NewShakeStream(sequentialBytes(32), nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	src, dst := make([]byte, 8192), make([]byte, 8192)
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		s.XORKeyStream(dst, src)
+	}
+}
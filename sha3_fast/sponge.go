@@ -0,0 +1,47 @@
+package sha3_fast
+
+import (
+	"fmt"
+	"hash"
+	"io"
+)
+
+// Sponge is a Keccak sponge with arbitrary parameters, as returned by
+// NewSponge. Sum pads a copy of the sponge and squeezes its default output
+// length from it, and Read squeezes an arbitrary amount of output.
+type Sponge interface {
+	hash.Hash
+	io.Reader
+
+	// Clone returns a copy of the Sponge in its current state.
+	Clone() ShakeHash
+}
+
+// NewSponge creates a Keccak sponge that absorbs rate bytes per call of the
+// permutation, so its capacity is 200-rate bytes, and which applies only the
+// last rounds rounds of KeccakF-1600. dsbyte holds the domain separation bits
+// followed by the first bit of the padding, as in the state struct: 0x06 for
+// SHA-3, 0x1f for SHAKE and 0x01 for the original Keccak submission. outputLen
+// is the output size of Sum.
+//
+// For instance NewSponge(136, 0x06, 32, 24) is SHA3-256 and
+// NewSponge(200-56, 0x01, 28, 24) is Keccak[c=448]. The rate must be a
+// positive multiple of 8 bytes of at most 192, and rounds must be between 1
+// and 24. Only full 24 round sponges use the assembly permutations.
+func NewSponge(rate int, dsbyte byte, outputLen, rounds int) (Sponge, error) {
+	if rate <= 0 || rate > maxRate || rate%8 != 0 {
+		return nil, fmt.Errorf("sha3: invalid sponge rate %d", rate)
+	}
+	if dsbyte == 0 {
+		return nil, fmt.Errorf("sha3: sponge domain separation byte has no padding bit")
+	}
+	if outputLen < 0 {
+		return nil, fmt.Errorf("sha3: invalid sponge output length %d", outputLen)
+	}
+	if rounds < 1 || rounds > fullRounds {
+		return nil, fmt.Errorf("sha3: invalid number of sponge rounds %d", rounds)
+	}
+	d := newState(rate, outputLen, dsbyte)
+	d.rounds = rounds
+	return d, nil
+}
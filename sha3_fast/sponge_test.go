@@ -0,0 +1,132 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+// mustNewSponge returns the sponge with the given parameters, which must be
+// valid.
+func mustNewSponge(t *testing.T, rate int, dsbyte byte, outputLen, rounds int) Sponge {
+	s, err := NewSponge(rate, dsbyte, outputLen, rounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// TestNewSpongeStandard checks that NewSponge builds the standard instances
+// given their parameters.
+func TestNewSpongeStandard(t *testing.T) {
+	testUnalignedAndGeneric(t, func(impl string) {
+		for _, tc := range []struct {
+			name   string
+			rate   int
+			dsbyte byte
+			new    func() hash.Hash
+		}{
+			{"SHA3-224", 144, 0x06, New224},
+			{"SHA3-256", 136, 0x06, New256},
+			{"SHA3-384", 104, 0x06, New384},
+			{"SHA3-512", 72, 0x06, New512},
+			{"SHAKE128", 168, 0x1f, newHashShake128},
+			{"SHAKE256", 136, 0x1f, newHashShake256},
+		} {
+			for _, size := range []int{0, 1, tc.rate, 3*tc.rate + 5} {
+				msg := sequentialBytes(size)
+				want := tc.new()
+				want.Write(msg)
+				got := mustNewSponge(t, tc.rate, tc.dsbyte, want.Size(), 24)
+				got.Write(msg)
+				if !bytes.Equal(got.Sum(nil), want.Sum(nil)) {
+					t.Errorf("%s (%s): NewSponge differs for a %d byte message", tc.name, impl, size)
+				}
+			}
+		}
+	})
+}
+
+// TestNewSpongeReducedRounds checks a 12 round sponge against the
+// KangarooTwelve test vector for an empty message and customization string,
+// which is a single call of the inner sponge on the encoded length byte.
+func TestNewSpongeReducedRounds(t *testing.T) {
+	want := "1ac2d450fc3b4205d19da7bfca1b37513c0803577ac7167f06fe2ce1f0ef39e5"
+	testUnalignedAndGeneric(t, func(impl string) {
+		s := mustNewSponge(t, 168, 0x07, 32, 12)
+		s.Write([]byte{0})
+		if got := hex.EncodeToString(s.Sum(nil)); got != want {
+			t.Errorf("KangarooTwelve (%s): got %s, want %s", impl, got, want)
+		}
+	})
+}
+
+// TestNewSpongeNonStandard checks that sponges with rates and numbers of
+// rounds that no standard instance uses give the same output with every
+// implementation, across messages of several blocks.
+func TestNewSpongeNonStandard(t *testing.T) {
+	for _, params := range [][2]int{{8, 24}, {192, 24}, {96, 1}, {176, 14}} {
+		rate, rounds := params[0], params[1]
+		msg := sequentialBytes(5*rate + 3)
+		var want []byte
+		testUnalignedAndGeneric(t, func(impl string) {
+			s := mustNewSponge(t, rate, 0x01, 0, rounds)
+			s.Write(msg[:7])
+			s.Write(msg[7:])
+			got := make([]byte, 3*rate)
+			s.Read(got)
+			if want == nil {
+				want = got
+			} else if !bytes.Equal(got, want) {
+				t.Errorf("rate=%d, rounds=%d: %s output differs from generic", rate, rounds, impl)
+			}
+		})
+	}
+}
+
+// TestKeccakP1600Generic checks the step by step permutation against the
+// unrolled one, and that it starts at the right round.
+func TestKeccakP1600Generic(t *testing.T) {
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i) * 0x0123456789abcdef
+	}
+	b = a
+	keccakF1600Generic(&a)
+	keccakP1600Generic(&b, 24)
+	if a != b {
+		t.Fatalf("24 rounds differ from keccakF1600Generic")
+	}
+	for rounds := 1; rounds < 24; rounds++ {
+		c := a
+		keccakP1600Generic(&c, rounds)
+		d := a
+		onOrdinaryLanes(func(a *[25]uint64) { keccakP1600Interleaved(a, rounds) })(&d)
+		if c != d {
+			t.Errorf("%d rounds differ from the interleaved implementation", rounds)
+		}
+	}
+}
+
+func TestNewSpongeInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		rate      int
+		dsbyte    byte
+		outputLen int
+		rounds    int
+	}{
+		{0, 0x06, 32, 24},
+		{-8, 0x06, 32, 24},
+		{137, 0x06, 32, 24},
+		{200, 0x06, 32, 24},
+		{136, 0, 32, 24},
+		{136, 0x06, -1, 24},
+		{136, 0x06, 32, 0},
+		{136, 0x06, 32, 25},
+	} {
+		if _, err := NewSponge(tc.rate, tc.dsbyte, tc.outputLen, tc.rounds); err == nil {
+			t.Errorf("NewSponge(%d, %#x, %d, %d) succeeded", tc.rate, tc.dsbyte, tc.outputLen, tc.rounds)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package sha3_fast
+
+// This file implements the STROBE protocol framework, version 1.0.2, as
+// described at https://strobe.sourceforge.io/specs/, on KeccakF-1600.
+
+import "errors"
+
+// StrobeFlags are the flags that make up a STROBE operation.
+type StrobeFlags uint8
+
+// The STROBE flags. StrobeK, for keytree operations, isn't supported.
+const (
+	StrobeI StrobeFlags = 1 << iota // inbound, the data is received
+	StrobeA                         // the data is sent to or from the application
+	StrobeC                         // the data is combined with the cipher state
+	StrobeT                         // the data is sent to or from the transport
+	StrobeM                         // the operation is on metadata
+	StrobeK                         // keytree
+)
+
+// The STROBE operations. Each of them can be combined with StrobeM for the
+// meta operation of the same kind.
+const (
+	StrobeAD      = StrobeA
+	StrobeKEY     = StrobeA | StrobeC
+	StrobePRF     = StrobeI | StrobeA | StrobeC
+	StrobeSendCLR = StrobeA | StrobeT
+	StrobeRecvCLR = StrobeI | StrobeA | StrobeT
+	StrobeSendENC = StrobeA | StrobeC | StrobeT
+	StrobeRecvENC = StrobeI | StrobeA | StrobeC | StrobeT
+	StrobeSendMAC = StrobeC | StrobeT
+	StrobeRecvMAC = StrobeI | StrobeC | StrobeT
+	StrobeRATCHET = StrobeC
+)
+
+// ErrStrobeMAC is returned by a recv_MAC operation when the MAC doesn't
+// match.
+var ErrStrobeMAC = errors.New("sha3: STROBE MAC verification failed")
+
+// Strobe is the state of one side of a STROBE protocol.
+type Strobe struct {
+	// d holds the state as of the last permutation in its lanes, so the
+	// permutation dispatch and lane layout are those of the sponges.
+	d *state
+
+	// st holds the rate bytes of the current state, and prev those of the
+	// state as of the last permutation, so that their difference can be
+	// xored into d.
+	st   [maxRate]byte
+	prev [maxRate]byte
+
+	r        int // the number of bytes of st available for data, STROBE's R
+	pos      int // the position in st of the next byte
+	posBegin byte
+
+	// Whether we're the initiator, and whether that is known yet.
+	i0     StrobeFlags
+	i0Set  bool
+	flags  StrobeFlags // the flags of the current operation, for streaming
+	inited bool        // whether the padding is applied when permuting
+}
+
+// NewStrobe creates a STROBE instance with the given security level in bits,
+// 128 or 256, and absorbs the protocol name as metadata.
+func NewStrobe(protocol string, security int) (*Strobe, error) {
+	if security != 128 && security != 256 {
+		return nil, errors.New("sha3: STROBE security must be 128 or 256 bits")
+	}
+	rate := 200 - security/4
+	s := &Strobe{d: newState(rate, 0, 0), r: rate - 2}
+	domain := append([]byte{1, byte(rate), 1, 0, 1, 12 * 8}, "STROBEv1.0.2"...)
+	s.duplex(nil, domain, len(domain), false, false, true)
+	s.inited = true
+	s.Operate(StrobeAD|StrobeM, []byte(protocol), false)
+	return s, nil
+}
+
+// Clone returns a copy of the Strobe in its current state, which can then be
+// used independently.
+func (s *Strobe) Clone() *Strobe {
+	ret := *s
+	ret.d = s.d.clone()
+	return &ret
+}
+
+// runF pads the state, if initialization is done, and permutes it.
+func (s *Strobe) runF() {
+	if s.inited {
+		s.st[s.pos] ^= s.posBegin
+		s.st[s.pos+1] ^= 0x04
+		s.st[s.r+1] ^= 0x80
+	}
+	rate := s.r + 2
+	for i := range s.st[:rate] {
+		s.st[i] ^= s.prev[i]
+	}
+	xorIn(s.d, s.st[:rate])
+	s.d.permuteLanes()
+	copyOut(s.d, s.st[:rate])
+	copy(s.prev[:rate], s.st[:rate])
+	s.pos, s.posBegin = 0, 0
+}
+
+// duplex absorbs n bytes of src, or zeros if src is nil, combining them with
+// the state before or after absorbing them. The resulting bytes are written
+// to dst unless it is nil, and their OR is returned.
+func (s *Strobe) duplex(dst, src []byte, n int, cbefore, cafter, forceF bool) byte {
+	var or byte
+	for i := 0; i < n; i++ {
+		var b byte
+		if src != nil {
+			b = src[i]
+		}
+		if cbefore {
+			b ^= s.st[s.pos]
+		}
+		s.st[s.pos] ^= b
+		if cafter {
+			b = s.st[s.pos]
+		}
+		if dst != nil {
+			dst[i] = b
+		}
+		or |= b
+		s.pos++
+		if s.pos == s.r {
+			s.runF()
+		}
+	}
+	if forceF && s.pos != 0 {
+		s.runF()
+	}
+	return or
+}
+
+// beginOp absorbs the flags of a new operation.
+func (s *Strobe) beginOp(flags StrobeFlags) {
+	if flags&StrobeT != 0 {
+		if !s.i0Set {
+			s.i0, s.i0Set = flags&StrobeI, true
+		}
+		flags ^= s.i0
+	}
+	oldBegin := s.posBegin
+	s.posBegin = byte(s.pos + 1)
+	forceF := flags&(StrobeC|StrobeK) != 0
+	s.duplex(nil, []byte{oldBegin, byte(flags)}, 2, false, false, forceF)
+}
+
+// Operate runs the STROBE operation with the given flags on data, and if more
+// is set continues the previous operation, which must have the same flags.
+//
+// The operations that output data, send_ENC, recv_ENC, PRF and send_MAC,
+// overwrite data with it, and PRF, send_MAC and RATCHET only use the length
+// of data. recv_MAC returns ErrStrobeMAC if data isn't the expected MAC, and
+// can't be continued. It panics if the flags aren't those of an operation.
+func (s *Strobe) Operate(flags StrobeFlags, data []byte, more bool) error {
+	return s.operate(flags, data, len(data), more)
+}
+
+func (s *Strobe) operate(flags StrobeFlags, data []byte, n int, more bool) error {
+	switch flags &^ StrobeM {
+	case StrobeAD, StrobeKEY, StrobePRF, StrobeSendCLR, StrobeRecvCLR,
+		StrobeSendENC, StrobeRecvENC, StrobeSendMAC, StrobeRecvMAC, StrobeRATCHET:
+	default:
+		panic("sha3: invalid STROBE operation")
+	}
+	recvMAC := flags&(StrobeI|StrobeA|StrobeT) == StrobeI|StrobeT
+	if more {
+		if flags != s.flags {
+			panic("sha3: STROBE operation continued with different flags")
+		}
+		if recvMAC {
+			panic("sha3: STROBE recv_MAC continued")
+		}
+	} else {
+		s.beginOp(flags)
+		s.flags = flags
+	}
+
+	cafter := flags&(StrobeC|StrobeI|StrobeT) == StrobeC|StrobeT
+	cbefore := flags&StrobeC != 0 && !cafter
+	// Operations that don't take their data from the application or the
+	// transport take a length, and absorb zeros.
+	src := data
+	if flags&(StrobeI|StrobeT) != StrobeI|StrobeT && flags&(StrobeI|StrobeA) != StrobeA {
+		src = nil
+	}
+	// Operations that output to the application or the transport.
+	var dst []byte
+	if flags&(StrobeI|StrobeA) == StrobeI|StrobeA || flags&(StrobeI|StrobeT) == StrobeT {
+		dst = data
+	}
+	if s.duplex(dst, src, n, cbefore, cafter, false) != 0 && recvMAC {
+		return ErrStrobeMAC
+	}
+	return nil
+}
+
+// KEY absorbs a key into the state.
+func (s *Strobe) KEY(key []byte) { s.Operate(StrobeKEY, key, false) }
+
+// AD absorbs associated data into the state.
+func (s *Strobe) AD(data []byte) { s.Operate(StrobeAD, data, false) }
+
+// PRF fills out with pseudorandom output from the state.
+func (s *Strobe) PRF(out []byte) { s.Operate(StrobePRF, out, false) }
+
+// SendCLR absorbs data sent in the clear.
+func (s *Strobe) SendCLR(data []byte) { s.Operate(StrobeSendCLR, data, false) }
+
+// RecvCLR absorbs data received in the clear.
+func (s *Strobe) RecvCLR(data []byte) { s.Operate(StrobeRecvCLR, data, false) }
+
+// SendENC encrypts data in place. It should be followed by SendMAC.
+func (s *Strobe) SendENC(data []byte) { s.Operate(StrobeSendENC, data, false) }
+
+// RecvENC decrypts data in place. It should be followed by RecvMAC.
+func (s *Strobe) RecvENC(data []byte) { s.Operate(StrobeRecvENC, data, false) }
+
+// SendMAC fills mac with a MAC of the protocol so far.
+func (s *Strobe) SendMAC(mac []byte) { s.Operate(StrobeSendMAC, mac, false) }
+
+// RecvMAC checks a received MAC, returning ErrStrobeMAC if it doesn't match.
+func (s *Strobe) RecvMAC(mac []byte) error { return s.Operate(StrobeRecvMAC, mac, false) }
+
+// RATCHET overwrites n bytes of the state with zeros, which prevents the
+// state from being rolled back to recover earlier secrets.
+func (s *Strobe) RATCHET(n int) { s.operate(StrobeRATCHET, nil, n, false) }
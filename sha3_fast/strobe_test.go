@@ -0,0 +1,203 @@
+package sha3_fast
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// strobeVectorsFilename holds the test vectors of
+// https://github.com/mimoo/StrobeGo, which list the whole state after each
+// operation.
+const strobeVectorsFilename = "testdata/strobeVectors.json.deflate"
+
+type strobeVectors struct {
+	Vectors []struct {
+		Name       string `json:"name"`
+		Operations []struct {
+			Name         string `json:"name"`
+			CustomString string `json:"custom_string"`
+			Security     int    `json:"security"`
+			Meta         bool   `json:"meta"`
+			InputData    string `json:"input_data"`
+			InputLength  int    `json:"input_length"`
+			Output       string `json:"output"`
+			StateAfter   string `json:"state_after"`
+			Stream       bool   `json:"stream"`
+		} `json:"operations"`
+	} `json:"test_vectors"`
+}
+
+var strobeOperations = map[string]StrobeFlags{
+	"AD":       StrobeAD,
+	"KEY":      StrobeKEY,
+	"PRF":      StrobePRF,
+	"send_CLR": StrobeSendCLR,
+	"recv_CLR": StrobeRecvCLR,
+	"send_ENC": StrobeSendENC,
+	"recv_ENC": StrobeRecvENC,
+	"send_MAC": StrobeSendMAC,
+	"recv_MAC": StrobeRecvMAC,
+	"RATCHET":  StrobeRATCHET,
+}
+
+// strobeState returns the 200 bytes of the state of s.
+func strobeState(s *Strobe) []byte {
+	out := make([]byte, 200)
+	for i, lane := range s.d.a {
+		if interleavedLanes {
+			lo, hi := deinterleave(uint32(lane), uint32(lane>>32))
+			lane = uint64(hi)<<32 | uint64(lo)
+		}
+		binary.LittleEndian.PutUint64(out[8*i:], lane)
+	}
+	copy(out, s.st[:s.r+2])
+	return out
+}
+
+func TestStrobeVectors(t *testing.T) {
+	deflated, err := os.Open(strobeVectorsFilename)
+	if err != nil {
+		t.Fatalf("error opening %s: %s", strobeVectorsFilename, err)
+	}
+	var vectors strobeVectors
+	err = json.NewDecoder(flate.NewReader(deflated)).Decode(&vectors)
+	deflated.Close()
+	if err != nil {
+		t.Fatalf("error decoding STROBE vectors: %s", err)
+	}
+
+	testUnalignedAndGeneric(t, func(impl string) {
+		for _, v := range vectors.Vectors {
+			var s *Strobe
+			for i, op := range v.Operations {
+				var got []byte
+				if op.Name == "init" {
+					if s, err = NewStrobe(op.CustomString, op.Security); err != nil {
+						t.Fatal(err)
+					}
+				} else {
+					flags, ok := strobeOperations[op.Name]
+					if !ok {
+						t.Fatalf("%s: unknown operation %s", v.Name, op.Name)
+					}
+					if op.Meta {
+						flags |= StrobeM
+					}
+					data := decodeHex(op.InputData)
+					if op.InputLength != 0 {
+						data = make([]byte, op.InputLength)
+					}
+					err := s.Operate(flags, data, op.Stream)
+					switch {
+					case flags == StrobeRecvMAC || flags == StrobeRecvMAC|StrobeM:
+						// The vectors give the OR of the MAC difference.
+						if (op.Output == "00") != (err == nil) {
+							t.Errorf("%s (%s), operation %d: recv_MAC returned %v", v.Name, impl, i, err)
+						}
+					case op.Output != "":
+						got = data
+					}
+				}
+				if got != nil && !bytes.Equal(got, decodeHex(op.Output)) {
+					t.Errorf("%s (%s), operation %d %s: got output %x, want %s", v.Name, impl, i, op.Name, got, op.Output)
+				}
+				if state := hex.EncodeToString(strobeState(s)); state != op.StateAfter {
+					t.Fatalf("%s (%s), operation %d %s: got state\n  %s\nwant\n  %s", v.Name, impl, i, op.Name, state, op.StateAfter)
+				}
+			}
+		}
+	})
+}
+
+// TestStrobeRoundTrip checks that the receiving side decrypts and
+// authenticates what the sending side sent, and that it notices tampering.
+func TestStrobeRoundTrip(t *testing.T) {
+	for _, security := range []int{128, 256} {
+		alice, err := NewStrobe("round trip", security)
+		if err != nil {
+			t.Fatal(err)
+		}
+		alice.KEY([]byte("shared secret"))
+		bob := alice.Clone()
+
+		msg := sequentialBytes(500)
+		ct := append([]byte(nil), msg...)
+		alice.SendENC(ct)
+		mac := make([]byte, 16)
+		alice.SendMAC(mac)
+
+		bobTampered := bob.Clone()
+		bob.RecvENC(ct)
+		if !bytes.Equal(ct, msg) {
+			t.Errorf("security=%d: decryption failed", security)
+		}
+		if err := bob.RecvMAC(mac); err != nil {
+			t.Errorf("security=%d: %v", security, err)
+		}
+
+		ct[17] ^= 1
+		bobTampered.RecvENC(ct)
+		if err := bobTampered.RecvMAC(mac); err != ErrStrobeMAC {
+			t.Errorf("security=%d: tampered message gave %v", security, err)
+		}
+	}
+}
+
+// TestStrobeStreaming checks that operations continued with more give the
+// same results as a single operation.
+func TestStrobeStreaming(t *testing.T) {
+	whole, err := NewStrobe("streaming", 128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := whole.Clone()
+
+	msg := sequentialBytes(400)
+	whole.AD(msg)
+	want := make([]byte, 300)
+	whole.PRF(want)
+
+	parts.Operate(StrobeAD, msg[:1], false)
+	parts.Operate(StrobeAD, msg[1:170], true)
+	parts.Operate(StrobeAD, msg[170:], true)
+	got := make([]byte, 300)
+	parts.Operate(StrobePRF, got[:200], false)
+	parts.Operate(StrobePRF, got[200:], true)
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestStrobeMisuse(t *testing.T) {
+	s, err := NewStrobe("misuse", 128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewStrobe("misuse", 192); err == nil {
+		t.Errorf("NewStrobe accepted 192 bit security")
+	}
+	s.AD([]byte("data"))
+	for name, f := range map[string]func(){
+		"different flags": func() { s.Operate(StrobeKEY, []byte("key"), true) },
+		"keytree":         func() { s.Operate(StrobeK, nil, false) },
+		"invalid":         func() { s.Operate(StrobeI, nil, false) },
+		"recv_MAC more": func() {
+			s.Operate(StrobeRecvMAC, make([]byte, 1), false)
+			s.Operate(StrobeRecvMAC, make([]byte, 1), true)
+		},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s didn't panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,35 @@
+// +build arm,!appengine
+
+package sha3_fast
+
+import "unsafe"
+
+// ARMv6 and later can load and store words at any alignment, so the input
+// can be xored into the state a word at a time rather than being decoded a
+// byte at a time. ARMv5 can't, so the generic implementation is used until
+// the init function in keccakf_arm.go has checked goarm, and under gccgo.
+
+// xorInUnalignedARM xors the bytes in buf into the state a word at a time,
+// which relies on the CPU handling unaligned loads.
+func xorInUnalignedARM(d *state, buf []byte) {
+	bw := unsafe.Slice((*uint32)(unsafe.Pointer(&buf[0])), len(buf)/8*2)
+	aw := (*[50]uint32)(unsafe.Pointer(d.a))
+	for i, w := range bw {
+		aw[i] ^= w
+	}
+}
+
+// copyOutUnalignedARM copies the lanes of the state to a byte buffer.
+func copyOutUnalignedARM(d *state, buf []byte) {
+	ab := unsafe.Slice((*uint8)(unsafe.Pointer(&d.a[0])), len(buf))
+	copy(buf, ab)
+}
+
+var (
+	xorIn            = xorInGeneric
+	copyOut          = copyOutGeneric
+	xorInUnaligned   = xorInGeneric
+	copyOutUnaligned = copyOutGeneric
+)
+
+const xorImplementationUnaligned = "unaligned"
@@ -10,8 +10,15 @@ package sha3_fast
 import "unsafe"
 
 func xorInUnaligned(d *state, buf []byte) {
-	bw := (*[maxRate / 8]uint64)(unsafe.Pointer(&buf[0]))
 	n := len(buf)
+	bw := unsafe.Slice((*uint64)(unsafe.Pointer(&buf[0])), n/8)
+	if !isStandardRate(n) {
+		// The rate of a sponge from NewSponge.
+		for i := 0; i < n/8; i++ {
+			d.a[i] ^= bw[i]
+		}
+		return
+	}
 	if n >= 72 {
 		d.a[0] ^= bw[0]
 		d.a[1] ^= bw[1]
@@ -46,8 +53,8 @@ func xorInUnaligned(d *state, buf []byte) {
 }
 
 func copyOutUnaligned(d *state, buf []byte) {
-	ab := (*[maxRate]uint8)(unsafe.Pointer(&d.a[0]))
-	copy(buf, ab[:])
+	ab := unsafe.Slice((*uint8)(unsafe.Pointer(&d.a[0])), len(buf))
+	copy(buf, ab)
 }
 
 var (